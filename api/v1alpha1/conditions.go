@@ -7,6 +7,7 @@ const (
 	//   - False
 	//   Reason:
 	//   - Failed
+	//   - IssuerNotFound, InvalidConfiguration, APIConflict or PermissionDenied, when the failure cause is known
 	Degraded string = "Degraded"
 
 	// Ready is the condition type used to inform state of readiness of the operator to process external-secrets enabling requests.
@@ -14,9 +15,12 @@ const (
 	//   - True
 	//   - False
 	//   Reason:
-	//   - Progressing
-	//   - Failed
+	//   - Progressing: reconciliation is being retried, or the operand workloads are being rolled out
+	//   - Failed: reconciliation failed with irrecoverable error, or the operand pods are failing, like on ImagePullBackOff
+	//   - IssuerNotFound, InvalidConfiguration, APIConflict or PermissionDenied, when the failure cause is known
 	//   - Ready: operand successfully deployed and ready
+	//   - Deleting: operand resources are being deleted, as per the deletionPolicy or the managementState
+	//   - Removed: operand resources are deleted, as the managementState is Removed
 	Ready string = "Ready"
 
 	// UpdateAnnotation is the condition type used to inform status of updating the annotations.
@@ -27,6 +31,94 @@ const (
 	//   - Completed
 	//   - Failed
 	UpdateAnnotation string = "UpdateAnnotation"
+
+	// CertManagerInstalled is the condition type used to inform whether cert-manager is installed in the cluster,
+	// which is detected even when installed or removed after the operator has started.
+	//   Status:
+	//   - True
+	//   - False
+	//   Reason:
+	//   - Installed
+	//   - NotInstalled
+	CertManagerInstalled string = "CertManagerInstalled"
+
+	// CoreControllerAvailable is the condition type used to inform availability of the external-secrets controller deployment.
+	//   Status:
+	//   - True
+	//   - False
+	//   Reason:
+	//   - Available
+	//   - Progressing: deployment is being rolled out
+	//   - Failed: deployment rollout exceeded its progress deadline
+	//   - The pod-level reason preventing the pods from becoming ready, like ImagePullBackOff or CrashLoopBackOff
+	CoreControllerAvailable string = "CoreControllerAvailable"
+
+	// WebhookAvailable is the condition type used to inform availability of the external-secrets webhook deployment,
+	// and of the webhook service endpoints. Status and Reason are same as of CoreControllerAvailable.
+	WebhookAvailable string = "WebhookAvailable"
+
+	// CertControllerAvailable is the condition type used to inform availability of the external-secrets cert-controller
+	// deployment, which is present only when cert-manager is not configured for the webhook certificates. Status and
+	// Reason are same as of CoreControllerAvailable.
+	CertControllerAvailable string = "CertControllerAvailable"
+
+	// BitwardenSDKServerAvailable is the condition type used to inform availability of the bitwarden-sdk-server deployment,
+	// which is present only when the bitwarden provider plugin is enabled. Status and Reason are same as of CoreControllerAvailable.
+	BitwardenSDKServerAvailable string = "BitwardenSDKServerAvailable"
+
+	// CertificatesReady is the condition type used to inform the readiness of the cert-manager Certificates, and
+	// of the referenced Issuer or ClusterIssuer, which is present only when cert-manager is configured for obtaining
+	// the certificates.
+	//   Status:
+	//   - True
+	//   - False
+	//   Reason:
+	//   - Ready
+	//   - Progressing: certificates are being issued, or the issuer is not ready yet
+	//   - Failed: certificates issuance failed, or the issuer is not ready
+	CertificatesReady string = "CertificatesReady"
+
+	// CertificateExpiring is the condition type used to warn that a user provided certificate, configured in the
+	// webhookConfig.certificateSecretRef or bitwardenSecretManagerProvider.secretRef, expires within the
+	// certificateExpiryWarningWindow, which is present only when such a certificate is configured.
+	//   Status:
+	//   - True
+	//   - False
+	//   Reason:
+	//   - Valid
+	//   - Expiring: certificate expires within the certificateExpiryWarningWindow
+	//   - Expired
+	CertificateExpiring string = "CertificateExpiring"
+
+	// NetworkPoliciesApplied is the condition type used to inform status of applying the network policies.
+	//   Status:
+	//   - True
+	//   - False
+	//   Reason:
+	//   - Applied
+	//   - Failed
+	//   - InvalidConfiguration, APIConflict or PermissionDenied
+	NetworkPoliciesApplied string = "NetworkPoliciesApplied"
+
+	// ReconciliationPaused is the condition type used to inform that the operand resources are not being kept in
+	// the desired state, either all of them when the managementState is Unmanaged, or the modified resources
+	// annotated for opting out of drift correction, which are listed in the message. The condition is present
+	// only when the reconciliation is paused.
+	//   Status:
+	//   - True
+	//   Reason:
+	//   - Unmanaged
+	//   - DriftCorrectionDisabled
+	ReconciliationPaused string = "ReconciliationPaused"
+
+	// UnsupportedConfigOverridesApplied is the condition type used to warn that the operand resources are customized
+	// with the unsupportedConfigOverrides, which lists the patched resources and the overrides not matching any
+	// resource in the message. The condition is present only when the overrides are configured.
+	//   Status:
+	//   - True
+	//   Reason:
+	//   - Applied
+	UnsupportedConfigOverridesApplied string = "UnsupportedConfigOverridesApplied"
 )
 
 const (
@@ -37,4 +129,41 @@ const (
 	ReasonInProgress string = "Progressing"
 
 	ReasonCompleted string = "Completed"
+
+	ReasonDeleting string = "Deleting"
+
+	ReasonInstalled string = "Installed"
+
+	ReasonNotInstalled string = "NotInstalled"
+
+	ReasonAvailable string = "Available"
+
+	ReasonApplied string = "Applied"
+
+	ReasonRemoved string = "Removed"
+
+	ReasonUnmanaged string = "Unmanaged"
+
+	ReasonDriftCorrectionDisabled string = "DriftCorrectionDisabled"
+
+	ReasonValid string = "Valid"
+
+	ReasonExpiring string = "Expiring"
+
+	ReasonExpired string = "Expired"
+)
+
+// Reasons set on the Degraded, Ready and the component conditions for categorizing the failures.
+const (
+	// ReasonIssuerNotFound is the reason used when the cert-manager issuer configured does not exist.
+	ReasonIssuerNotFound string = "IssuerNotFound"
+
+	// ReasonInvalidConfiguration is the reason used when the configuration cannot be applied as is.
+	ReasonInvalidConfiguration string = "InvalidConfiguration"
+
+	// ReasonAPIConflict is the reason used when the resource was modified or created by another actor concurrently.
+	ReasonAPIConflict string = "APIConflict"
+
+	// ReasonPermissionDenied is the reason used when the operator is not permitted to perform an action.
+	ReasonPermissionDenied string = "PermissionDenied"
 )
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -29,6 +30,7 @@ type ExternalSecretsConfigList struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=externalsecretsconfigs,scope=Cluster,categories={external-secrets-operator, external-secrets},shortName=esc;externalsecretsconfig;esconfig
 // +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].reason"
 // +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].message"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:metadata:labels={"app.kubernetes.io/name=externalsecretsconfig", "app.kubernetes.io/part-of=external-secrets-operator"}
@@ -39,6 +41,7 @@ type ExternalSecretsConfigList struct {
 // When an ExternalSecretsConfig is created, the controller installs the external-secrets and keeps it in the desired state.
 //
 // +kubebuilder:validation:XValidation:rule="self.metadata.name == 'cluster'",message="ExternalSecretsConfig is a singleton, .metadata.name must be 'cluster'"
+// +kubebuilder:validation:XValidation:rule="(has(oldSelf.spec) && has(oldSelf.spec.appConfig) && has(oldSelf.spec.appConfig.namespace) ? oldSelf.spec.appConfig.namespace : '') == (has(self.spec) && has(self.spec.appConfig) && has(self.spec.appConfig.namespace) ? self.spec.appConfig.namespace : '')",message="spec.appConfig.namespace cannot be changed once the ExternalSecretsConfig is created"
 // +operator-sdk:csv:customresourcedefinitions:displayName="ExternalSecretsConfig"
 type ExternalSecretsConfig struct {
 	metav1.TypeMeta `json:",inline"`
@@ -55,7 +58,8 @@ type ExternalSecretsConfig struct {
 }
 
 // ExternalSecretsConfigSpec is for configuring the external-secrets operand behavior.
-// +kubebuilder:validation:XValidation:rule="!has(self.plugins) || !has(self.plugins.bitwardenSecretManagerProvider) || !has(self.plugins.bitwardenSecretManagerProvider.mode) || self.plugins.bitwardenSecretManagerProvider.mode != 'Enabled' || has(self.plugins.bitwardenSecretManagerProvider.secretRef) || (has(self.controllerConfig) && has(self.controllerConfig.certProvider) && has(self.controllerConfig.certProvider.certManager) && has(self.controllerConfig.certProvider.certManager.mode) && self.controllerConfig.certProvider.certManager.mode == 'Enabled')",message="secretRef or certManager must be configured when bitwardenSecretManagerProvider plugin is enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.plugins) || !has(self.plugins.bitwardenSecretManagerProvider) || !has(self.plugins.bitwardenSecretManagerProvider.mode) || self.plugins.bitwardenSecretManagerProvider.mode != 'Enabled' || has(self.plugins.bitwardenSecretManagerProvider.secretRef) || (has(self.controllerConfig) && has(self.controllerConfig.certProvider) && has(self.controllerConfig.certProvider.certManager) && has(self.controllerConfig.certProvider.certManager.mode) && self.controllerConfig.certProvider.certManager.mode == 'Enabled') || (has(self.controllerConfig) && has(self.controllerConfig.certProvider) && has(self.controllerConfig.certProvider.serviceCA) && has(self.controllerConfig.certProvider.serviceCA.mode) && self.controllerConfig.certProvider.serviceCA.mode == 'Enabled')",message="secretRef, certManager or serviceCA must be configured when bitwardenSecretManagerProvider plugin is enabled"
+// +kubebuilder:validation:XValidation:rule="!has(self.appConfig) || !has(self.appConfig.webhookConfig) || !has(self.appConfig.webhookConfig.certificateSecretRef) || !has(self.controllerConfig) || !has(self.controllerConfig.certProvider) || ((!has(self.controllerConfig.certProvider.certManager) || self.controllerConfig.certProvider.certManager.mode != 'Enabled') && (!has(self.controllerConfig.certProvider.serviceCA) || self.controllerConfig.certProvider.serviceCA.mode != 'Enabled'))",message="webhookConfig.certificateSecretRef cannot be configured when certManager or serviceCA is enabled"
 type ExternalSecretsConfigSpec struct {
 	// appConfig is for specifying the configurations for the `external-secrets` operand.
 	// +kubebuilder:validation:Optional
@@ -68,8 +72,113 @@ type ExternalSecretsConfigSpec struct {
 	// controllerConfig is for specifying the configurations for the controller to use while installing the `external-secrets` operand and the plugins.
 	// +kubebuilder:validation:Optional
 	ControllerConfig ControllerConfig `json:"controllerConfig,omitempty"`
+
+	// deletionPolicy decides what happens to the resources created for the `external-secrets` operand, when ExternalSecretsConfig is deleted.
+	// Delete: All the resources created by the operator are deleted, starting with the validating webhook configurations for the
+	// admission of external-secrets custom resources to not fail with the webhook server no longer available.
+	// Orphan: The resources are retained as is, and are no longer reconciled by the operator. This is the default behavior.
+	// The operand namespace and the resources not created by the operator are retained with either of the policies.
+	// +kubebuilder:validation:Enum:=Delete;Orphan
+	// +kubebuilder:validation:Optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// managementState indicates whether and how the operator manages the `external-secrets` operand.
+	// Managed: The operand is installed and its resources are kept in the desired state, which is the default behavior.
+	// Unmanaged: The operand resources are neither created, updated nor deleted, for allowing them to be modified manually,
+	// like during an incident. The operand status is still reported, and the resources are retained when the
+	// ExternalSecretsConfig is deleted, irrespective of the deletionPolicy.
+	// Removed: The resources created for the operand are deleted, same as on deleting the ExternalSecretsConfig with the
+	// Delete deletionPolicy, and are created again on changing back to Managed.
+	// A single resource can be opted out of the drift correction, while the others remain managed, by annotating it
+	// with `operator.openshift.io/external-secrets-unmanaged: "true"`.
+	// +kubebuilder:validation:Enum:=Managed;Unmanaged;Removed
+	// +kubebuilder:default:=Managed
+	// +kubebuilder:validation:Optional
+	ManagementState ManagementState `json:"managementState,omitempty"`
+
+	// unsupportedConfigOverrides is for patching the resources created for the `external-secrets` operand, for the
+	// customizations not supported by the other fields, like adding an environment variable, a hostAliases entry or
+	// a sidecar container to a deployment, or changing the timeoutSeconds of a validating webhook.
+	// The patches are applied in the order listed, after the resources are generated from the configuration, and
+	// before being compared with and applied to the existing resources.
+	// Overrides are not supported and can break the operand or future upgrades, hence the UnsupportedConfigOverridesApplied
+	// condition is set when configured, for identifying the customized installations.
+	// +kubebuilder:validation:MaxItems:=50
+	// +listType=atomic
+	// +kubebuilder:validation:Optional
+	UnsupportedConfigOverrides []ConfigOverride `json:"unsupportedConfigOverrides,omitempty"`
 }
 
+// ConfigOverride is a patch for a resource created for the `external-secrets` operand.
+type ConfigOverride struct {
+	// kind is the kind of the resource to patch, like Deployment or ValidatingWebhookConfiguration, which is
+	// matched case-insensitively.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// name is the name of the resource to patch. The resources are looked up in the operand namespace, or are
+	// cluster-scoped.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=253
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// type is the type of the patch.
+	// JSONPatch: patch is a list of RFC 6902 JSON patch operations.
+	// StrategicMergePatch: patch is a partial resource merged with the strategic merge patch semantics, like
+	// `kubectl patch --type=strategic`. A JSON merge patch is applied instead for the resources not having
+	// a strategic merge patch schema, like the ServiceMonitors.
+	// +kubebuilder:validation:Enum:=JSONPatch;StrategicMergePatch
+	// +kubebuilder:default:=StrategicMergePatch
+	// +kubebuilder:validation:Optional
+	Type ConfigOverrideType `json:"type,omitempty"`
+
+	// patch is the patch to apply in JSON or YAML format. The patch must not modify the name or the namespace
+	// of the resource.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=32768
+	// +kubebuilder:validation:Required
+	Patch string `json:"patch"`
+}
+
+// ConfigOverrideType is the type of the patch in a ConfigOverride.
+type ConfigOverrideType string
+
+const (
+	// JSONPatch indicates the patch is a list of RFC 6902 JSON patch operations.
+	JSONPatch ConfigOverrideType = "JSONPatch"
+
+	// StrategicMergePatch indicates the patch is a partial resource merged with the strategic merge patch semantics.
+	StrategicMergePatch ConfigOverrideType = "StrategicMergePatch"
+)
+
+// DeletionPolicy decides what happens to the resources created for the operand, when the owning resource is deleted.
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete indicates the resources created for the operand must be deleted.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyOrphan indicates the resources created for the operand must be retained.
+	DeletionPolicyOrphan DeletionPolicy = "Orphan"
+)
+
+// ManagementState indicates whether and how the operator manages the operand.
+type ManagementState string
+
+const (
+	// Managed indicates the operator installs the operand and keeps it in the desired state.
+	Managed ManagementState = "Managed"
+
+	// Unmanaged indicates the operator does not modify the operand resources, but reports the operand status.
+	Unmanaged ManagementState = "Unmanaged"
+
+	// Removed indicates the operator deletes the resources created for the operand.
+	Removed ManagementState = "Removed"
+)
+
 // ExternalSecretsConfigStatus is the most recently observed status of the ExternalSecretsConfig.
 type ExternalSecretsConfigStatus struct {
 	// conditions holds information of the current state of the external-secrets deployment.
@@ -80,10 +189,105 @@ type ExternalSecretsConfigStatus struct {
 
 	// BitwardenSDKServerImage is the name of the image and the tag used for deploying bitwarden-sdk-server.
 	BitwardenSDKServerImage string `json:"bitwardenSDKServerImage,omitempty"`
+
+	// desiredStateHash is the hash of the desired state of all the operand resources, as last applied
+	// successfully by the operator.
+	// +kubebuilder:validation:Optional
+	DesiredStateHash string `json:"desiredStateHash,omitempty"`
+
+	// lastAppliedGeneration is the generation of the ExternalSecretsConfig, whose desired state was last
+	// applied successfully on the operand resources.
+	// +kubebuilder:validation:Optional
+	LastAppliedGeneration int64 `json:"lastAppliedGeneration,omitempty"`
+
+	// certificateMigration is the status of the last migration of the webhook certificate to a different
+	// certificate provider, or to a different cert-manager issuer.
+	// +kubebuilder:validation:Optional
+	CertificateMigration *CertificateMigrationStatus `json:"certificateMigration,omitempty"`
+}
+
+// CertificateMigrationStatus is the status of the migration of the webhook certificate between the certificate providers.
+// The webhook continues to use the current certificate until the certificate of the new provider is issued, after which
+// the webhook is switched to it, and the resources of the previous provider, like the cert-controller, are removed.
+type CertificateMigrationStatus struct {
+	// phase is the current phase of the migration.
+	// Provisioning: The certificate is being issued by the new provider, and the webhook uses the current certificate.
+	// Switching: The webhook is switched to the new certificate, and the resources of the previous provider are removed.
+	// The phase lasts until the rollout of the webhook with the new certificate is complete.
+	// Completed: The webhook uses the certificate of the new provider.
+	// +kubebuilder:validation:Enum:=Provisioning;Switching;Completed
+	Phase CertificateMigrationPhase `json:"phase"`
+
+	// source is the provider of the webhook certificate being migrated from.
+	Source CertificateProviderReference `json:"source"`
+
+	// target is the provider of the webhook certificate being migrated to.
+	Target CertificateProviderReference `json:"target"`
+
+	// message is the human-readable description of the current phase.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+
+	// lastTransitionTime is the time of the transition to the current phase.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
 }
 
+// CertificateMigrationPhase is the phase of the webhook certificate migration.
+type CertificateMigrationPhase string
+
+const (
+	// CertificateMigrationProvisioning is when the certificate is being issued by the new provider.
+	CertificateMigrationProvisioning CertificateMigrationPhase = "Provisioning"
+
+	// CertificateMigrationSwitching is when the webhook is being switched to the new certificate.
+	CertificateMigrationSwitching CertificateMigrationPhase = "Switching"
+
+	// CertificateMigrationCompleted is when the webhook uses the certificate of the new provider.
+	CertificateMigrationCompleted CertificateMigrationPhase = "Completed"
+)
+
+// CertificateProviderReference identifies the provider of the webhook certificate.
+type CertificateProviderReference struct {
+	// provider is the name of the certificate provider.
+	// +kubebuilder:validation:Enum:=CertController;CertManager;ServiceCA;UserProvided
+	Provider CertificateProvider `json:"provider"`
+
+	// issuerRef is the cert-manager issuer of the certificate, when the provider is CertManager.
+	// +kubebuilder:validation:Optional
+	IssuerRef *ObjectReference `json:"issuerRef,omitempty"`
+
+	// secretName is the name of the secret containing the webhook certificate.
+	SecretName string `json:"secretName"`
+}
+
+// CertificateProvider is the provider of the webhook certificate.
+type CertificateProvider string
+
+const (
+	// CertControllerProvider is the in-built cert-controller.
+	CertControllerProvider CertificateProvider = "CertController"
+
+	// CertManagerProvider is cert-manager, configured with `spec.controllerConfig.certProvider.certManager`.
+	CertManagerProvider CertificateProvider = "CertManager"
+
+	// ServiceCAProvider is the OpenShift service-ca operator, configured with `spec.controllerConfig.certProvider.serviceCA`.
+	ServiceCAProvider CertificateProvider = "ServiceCA"
+
+	// UserProvidedProvider is the certificate configured with `spec.appConfig.webhookConfig.certificateSecretRef`.
+	UserProvidedProvider CertificateProvider = "UserProvided"
+)
+
 // ApplicationConfig is for specifying the configurations for the external-secrets operand.
 type ApplicationConfig struct {
+	// namespace is the namespace where the external-secrets operand resources are created, which is
+	// `external-secrets` when not configured. The namespace is created by the operator when it does not exist.
+	// This field is immutable once the ExternalSecretsConfig is created.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=63
+	// +kubebuilder:validation:Pattern:=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+
 	// operatingNamespace is for restricting the external-secrets operations to the provided namespace.
 	// When configured `ClusterSecretStore` and `ClusterExternalSecret` are implicitly disabled.
 	// +kubebuilder:validation:MinLength:=1
@@ -95,10 +299,204 @@ type ApplicationConfig struct {
 	// +kubebuilder:validation:Optional
 	WebhookConfig *WebhookConfig `json:"webhookConfig,omitempty"`
 
+	// certificateExpiryWarningWindow is the period before the expiry of the user provided certificates configured in
+	// `webhookConfig.certificateSecretRef` and `bitwardenSecretManagerProvider.secretRef`, from when the
+	// `CertificateExpiring` condition is set and a warning event is emitted. It is 720h (30 days) when not configured.
+	// +kubebuilder:validation:XValidation:rule="duration(self) > duration('0s')",message="certificateExpiryWarningWindow must be greater than 0s"
+	// +kubebuilder:validation:Optional
+	CertificateExpiryWarningWindow *metav1.Duration `json:"certificateExpiryWarningWindow,omitempty"`
+
+	// trustedCABundle is for configuring the additional CA certificates to be trusted by the
+	// external-secrets controller, webhook and bitwarden-sdk-server containers, like the CA of a
+	// secret backend using a private PKI.
+	// +kubebuilder:validation:Optional
+	TrustedCABundle *TrustedCABundleConfig `json:"trustedCABundle,omitempty"`
+
+	// replicas is for configuring the number of pods of the external-secrets controller and webhook.
+	// When a component has more than one replica, a PodDisruptionBudget is created for it, and the pods are
+	// spread across the nodes and zones, unless affinity is configured.
+	// +kubebuilder:validation:Optional
+	Replicas *ComponentReplicas `json:"replicas,omitempty"`
+
+	// controller is for tuning the external-secrets controller, like the number of resources reconciled
+	// concurrently, and the rate of the requests made to the API server.
+	// +kubebuilder:validation:Optional
+	Controller *CoreControllerConfig `json:"controller,omitempty"`
+
+	// features is for enabling or disabling the individual external-secrets reconcilers. The ClusterRole rules
+	// required for the disabled resource kinds are not granted to the external-secrets controller, and to the
+	// users through the aggregated ClusterRoles.
+	// +kubebuilder:validation:Optional
+	Features *FeaturesConfig `json:"features,omitempty"`
+
+	// components is for overriding the resource requirements and the scheduling configurations of the individual
+	// components, keyed by the component name. The configuration of a component takes precedence over the same
+	// configuration in the appConfig, which in turn takes precedence over the configuration in ExternalSecretsManager.
+	// The component name must be one of ExternalSecretsCoreController, Webhook, CertController or BitwardenSDKServer.
+	// +kubebuilder:validation:XValidation:rule="self.all(k, k in ['ExternalSecretsCoreController', 'Webhook', 'CertController', 'BitwardenSDKServer'])",message="component name must be one of ExternalSecretsCoreController, Webhook, CertController or BitwardenSDKServer"
+	// +mapType=granular
+	// +kubebuilder:validation:MinProperties:=0
+	// +kubebuilder:validation:MaxProperties:=4
+	// +kubebuilder:validation:Optional
+	Components map[ComponentName]ComponentConfig `json:"components,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	CommonConfigs `json:",inline"`
 }
 
+// CoreControllerConfig is for tuning the external-secrets controller. Any change in the configuration
+// will trigger a rollout of the external-secrets controller deployment.
+type CoreControllerConfig struct {
+	// concurrent is the number of ExternalSecret, PushSecret and SecretStore resources reconciled concurrently.
+	// +kubebuilder:default:=1
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=100
+	// +kubebuilder:validation:Optional
+	Concurrent int32 `json:"concurrent,omitempty"`
+
+	// storeRequeueInterval is the interval at which the SecretStore and ClusterSecretStore resources are
+	// reconciled, for validating the connectivity with the secret backend.
+	// +kubebuilder:default:="5m"
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="storeRequeueInterval must be at least 1s"
+	// +kubebuilder:validation:Optional
+	StoreRequeueInterval *metav1.Duration `json:"storeRequeueInterval,omitempty"`
+
+	// clientQPS is the maximum number of queries per second made by the controller to the API server.
+	// +kubebuilder:default:=50
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=1000
+	// +kubebuilder:validation:Optional
+	ClientQPS int32 `json:"clientQPS,omitempty"`
+
+	// clientBurst is the maximum burst of queries made by the controller to the API server, over the clientQPS.
+	// +kubebuilder:default:=100
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=2000
+	// +kubebuilder:validation:Optional
+	ClientBurst int32 `json:"clientBurst,omitempty"`
+
+	// enableSecretsCaching is for caching all the Secrets in the cluster in the controller, which reduces the
+	// requests made to the API server, at the cost of increased memory usage.
+	// Enabled: All the Secrets are cached, instead of only the Secrets managed by the controller.
+	// Disabled: Only the Secrets managed by the controller are cached, which is the default behavior.
+	// +kubebuilder:validation:Enum:=Enabled;Disabled
+	// +kubebuilder:default:=Disabled
+	// +kubebuilder:validation:Optional
+	EnableSecretsCaching Mode `json:"enableSecretsCaching,omitempty"`
+
+	// enableConfigMapsCaching is for caching all the ConfigMaps in the cluster in the controller, which reduces
+	// the requests made to the API server, at the cost of increased memory usage.
+	// Enabled: All the ConfigMaps are cached.
+	// Disabled: ConfigMaps are not cached, which is the default behavior.
+	// +kubebuilder:validation:Enum:=Enabled;Disabled
+	// +kubebuilder:default:=Disabled
+	// +kubebuilder:validation:Optional
+	EnableConfigMapsCaching Mode `json:"enableConfigMapsCaching,omitempty"`
+}
+
+// FeaturesConfig is for enabling or disabling the individual external-secrets reconcilers, which are all
+// enabled when not configured.
+type FeaturesConfig struct {
+	// pushSecret indicates whether the PushSecret resources, for writing the secrets to the secret backends,
+	// are reconciled. Disabling PushSecret implicitly disables ClusterPushSecret.
+	// +kubebuilder:validation:Enum:=Enabled;Disabled
+	// +kubebuilder:validation:Optional
+	PushSecret Mode `json:"pushSecret,omitempty"`
+
+	// clusterPushSecret indicates whether the ClusterPushSecret resources are reconciled.
+	// It is implicitly disabled when `spec.appConfig.operatingNamespace` is configured.
+	// +kubebuilder:validation:Enum:=Enabled;Disabled
+	// +kubebuilder:validation:Optional
+	ClusterPushSecret Mode `json:"clusterPushSecret,omitempty"`
+
+	// clusterExternalSecret indicates whether the ClusterExternalSecret resources are reconciled.
+	// It is implicitly disabled when `spec.appConfig.operatingNamespace` is configured.
+	// +kubebuilder:validation:Enum:=Enabled;Disabled
+	// +kubebuilder:validation:Optional
+	ClusterExternalSecret Mode `json:"clusterExternalSecret,omitempty"`
+
+	// clusterSecretStore indicates whether the ClusterSecretStore resources are reconciled.
+	// It is implicitly disabled when `spec.appConfig.operatingNamespace` is configured.
+	// +kubebuilder:validation:Enum:=Enabled;Disabled
+	// +kubebuilder:validation:Optional
+	ClusterSecretStore Mode `json:"clusterSecretStore,omitempty"`
+
+	// generators indicates whether the generators, like Password and ECRAuthorizationToken, can be
+	// referenced in the ExternalSecret and PushSecret resources.
+	// external-secrets does not provide an option for disabling the generators, and Disabled only revokes the
+	// access of the external-secrets controller to the `generators.external-secrets.io` resources. The
+	// ExternalSecret and PushSecret resources referencing a generator would then fail to be reconciled, with
+	// the access being denied as `forbidden` reported in their status and events.
+	// +kubebuilder:validation:Enum:=Enabled;Disabled
+	// +kubebuilder:validation:Optional
+	Generators Mode `json:"generators,omitempty"`
+}
+
+// ComponentConfig is for configuring the resource requirements and the scheduling of the pods of a component.
+type ComponentConfig struct {
+	// resources is for defining the resource requirements of the component containers.
+	// ref: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	// +kubebuilder:validation:Optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// affinity is for setting scheduling affinity rules of the component pods.
+	// ref: https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/
+	// +kubebuilder:validation:Optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// tolerations is for setting the component pod tolerations.
+	// ref: https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/
+	// This field can have a maximum of 50 entries.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems:=0
+	// +kubebuilder:validation:MaxItems:=50
+	// +kubebuilder:validation:Optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// nodeSelector is for defining the scheduling criteria of the component pods using node labels.
+	// ref: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+	// This field can have a maximum of 50 entries.
+	// +mapType=atomic
+	// +kubebuilder:validation:MinProperties:=0
+	// +kubebuilder:validation:MaxProperties:=50
+	// +kubebuilder:validation:Optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// priorityClassName is the name of the PriorityClass to be used for the component pods.
+	// ref: https://kubernetes.io/docs/concepts/scheduling-eviction/pod-priority-preemption/
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=253
+	// +kubebuilder:validation:Optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
+	// topologySpreadConstraints is for defining how the component pods are spread across the topology domains.
+	// When configured, the default zone spread constraint set for the components with more than one replica is
+	// not applied.
+	// ref: https://kubernetes.io/docs/concepts/scheduling-eviction/topology-spread-constraints/
+	// This field can have a maximum of 10 entries.
+	// +listType=atomic
+	// +kubebuilder:validation:MinItems:=0
+	// +kubebuilder:validation:MaxItems:=10
+	// +kubebuilder:validation:Optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// ComponentReplicas is for configuring the number of pods of the external-secrets components.
+type ComponentReplicas struct {
+	// controller is the number of pods of the external-secrets controller. Only the elected leader
+	// reconciles the external-secrets resources, and one of the others takes over when it is unavailable.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=10
+	// +kubebuilder:validation:Optional
+	Controller *int32 `json:"controller,omitempty"`
+
+	// webhook is the number of pods of the external-secrets webhook.
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=10
+	// +kubebuilder:validation:Optional
+	Webhook *int32 `json:"webhook,omitempty"`
+}
+
 // ControllerConfig is for specifying the configurations for the controller to use while installing the `external-secrets` operand and the plugins.
 type ControllerConfig struct {
 	// certProvider is for defining the configuration for certificate providers used to manage TLS certificates for webhook and plugins.
@@ -113,6 +511,16 @@ type ControllerConfig struct {
 	// +kubebuilder:validation:Optional
 	Labels map[string]string `json:"labels,omitempty"`
 
+	// monitoring is for configuring the Prometheus monitoring of the external-secrets operand.
+	// +kubebuilder:validation:Optional
+	Monitoring *MonitoringConfig `json:"monitoring,omitempty"`
+
+	// namespace is for configuring the labels and annotations of the namespace in which the external-secrets
+	// operand is installed, like the `openshift.io/cluster-monitoring` and `pod-security.kubernetes.io/enforce`
+	// labels or the `openshift.io/node-selector` annotation.
+	// +kubebuilder:validation:Optional
+	Namespace *NamespaceConfig `json:"namespace,omitempty"`
+
 	// networkPolicies specifies the list of network policy configurations
 	// to be applied to external-secrets pods.
 	//
@@ -132,11 +540,47 @@ type ControllerConfig struct {
 	NetworkPolicies []NetworkPolicy `json:"networkPolicies,omitempty"`
 }
 
+// MonitoringConfig is for configuring the Prometheus monitoring of the external-secrets operand.
+type MonitoringConfig struct {
+	// mode indicates whether the operand must be monitored, which can be indicated by setting Enabled or Disabled.
+	// Enabled: ServiceMonitors are created for scraping the metrics services of the operand, along with a PrometheusRule
+	// with the alerts for the secret sync errors, the webhook unavailability and the SecretStores not being ready. A
+	// NetworkPolicy, Role and RoleBinding are also created for the cluster monitoring Prometheus in the
+	// openshift-monitoring namespace to scrape the metrics. The resources are created only when the
+	// monitoring.coreos.com CRDs are available in the cluster.
+	// Disabled: The monitoring resources created by the operator will be removed.
+	// +kubebuilder:validation:Enum:=Enabled;Disabled
+	// +kubebuilder:default:=Disabled
+	// +kubebuilder:validation:Optional
+	Mode Mode `json:"mode,omitempty"`
+}
+
+// NamespaceConfig is for configuring the metadata of the external-secrets operand namespace. The configured labels
+// and annotations are added to the namespace, and are restored when modified. The labels and annotations added by
+// other actors are retained, and the ones removed from the configuration are not removed from the namespace.
+type NamespaceConfig struct {
+	// labels to apply to the external-secrets operand namespace.
+	// This field can have a maximum of 20 entries.
+	// +mapType=granular
+	// +kubebuilder:validation:MinProperties:=0
+	// +kubebuilder:validation:MaxProperties:=20
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// annotations to apply to the external-secrets operand namespace.
+	// This field can have a maximum of 20 entries.
+	// +mapType=granular
+	// +kubebuilder:validation:MinProperties:=0
+	// +kubebuilder:validation:MaxProperties:=20
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
 // BitwardenSecretManagerProvider is for enabling the bitwarden secrets manager provider and for setting up the additional service required for connecting with the bitwarden server.
 type BitwardenSecretManagerProvider struct {
 	// mode indicates bitwarden secrets manager provider state, which can be indicated by setting Enabled or Disabled.
 	// Enabled: Enables the Bitwarden provider plugin. The operator will ensure the plugin is deployed and its state is synchronized.
-	// Disabled: Disables reconciliation of the Bitwarden provider plugin. The plugin and its resources will remain in their current state and will not be managed by the operator.
+	// Disabled: Disables the Bitwarden provider plugin. The resources created for the plugin by the operator will be removed.
 	// +kubebuilder:validation:Enum:=Enabled;Disabled
 	// +kubebuilder:default:=Disabled
 	// +kubebuilder:validation:Optional
@@ -155,6 +599,37 @@ type WebhookConfig struct {
 	// +kubebuilder:default:="5m"
 	// +kubebuilder:validation:Optional
 	CertificateCheckInterval *metav1.Duration `json:"certificateCheckInterval,omitempty"`
+
+	// certificateSecretRef is the Kubernetes secret containing the TLS key pair to be used for the webhook server, instead of
+	// the certificate obtained by the in-built cert-controller, which is then not deployed. The secret must exist in the operand
+	// namespace, and the key names in secret for certificate must be `tls.crt`, for private key must be `tls.key` and for CA
+	// certificate must be `ca.crt`, which is set as the caBundle in the ValidatingWebhookConfigurations. The certificate must be
+	// valid for the `external-secrets-webhook.<namespace>.svc` DNS name. The secret is checked for changes by the operator
+	// every 5 minutes.
+	// +kubebuilder:validation:Optional
+	CertificateSecretRef *SecretReference `json:"certificateSecretRef,omitempty"`
+}
+
+// TrustedCABundleConfig is for configuring the CA certificates to be trusted by the operand containers. The
+// CA certificates are mounted into the containers and made available through the `SSL_CERT_DIR` environment
+// variable, along with the system default CA certificates. Any change to the CA certificates will trigger a
+// rollout of the operand deployments.
+// +kubebuilder:validation:XValidation:rule="(has(self.clusterTrustedCABundle) && self.clusterTrustedCABundle == 'Enabled') || has(self.configMapRef)",message="at least one of clusterTrustedCABundle or configMapRef must be configured"
+type TrustedCABundleConfig struct {
+	// clusterTrustedCABundle indicates whether to make use of the OpenShift cluster-wide trusted CA bundle, which
+	// includes the additional CA certificates configured in `proxies.config.openshift.io/cluster`.
+	// Enabled: The operator creates the `external-secrets-trusted-ca-bundle` ConfigMap in the operand namespace with the
+	// `config.openshift.io/inject-trusted-cabundle` label, for the cluster trusted CA bundle to be injected into it.
+	// Disabled: The cluster-wide trusted CA bundle is not made use of.
+	// +kubebuilder:validation:Enum:=Enabled;Disabled
+	// +kubebuilder:validation:Optional
+	ClusterTrustedCABundle Mode `json:"clusterTrustedCABundle,omitempty"`
+
+	// configMapRef is the reference to the ConfigMap containing the PEM encoded CA certificates to be trusted.
+	// The ConfigMap must exist in the operand namespace, and is watched by name for the changes in it to be
+	// detected immediately.
+	// +kubebuilder:validation:Optional
+	ConfigMapRef *ConfigMapKeyReference `json:"configMapRef,omitempty"`
 }
 
 // CertManagerConfig is for configuring cert-manager specifics.
@@ -164,24 +639,21 @@ type CertManagerConfig struct {
 	// mode indicates whether to use cert-manager for certificate management, instead of built-in cert-controller.
 	// Enabled: Makes use of cert-manager for obtaining the certificates for webhook server and other components.
 	// Disabled: Makes use of in-built cert-controller for obtaining the certificates for webhook server, which is the default behavior.
-	// This field is immutable once set.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="mode is immutable once set"
+	// Changing the mode migrates the webhook to the certificate of the new provider, which is tracked in `status.certificateMigration`.
 	// +kubebuilder:validation:Enum:=Enabled;Disabled
 	// +kubebuilder:validation:Required
 	Mode Mode `json:"mode,omitempty"`
 
 	// injectAnnotations is for adding the `cert-manager.io/inject-ca-from` annotation to the webhooks and CRDs to automatically setup webhook to use the cert-manager CA. This requires CA Injector to be enabled in cert-manager.
-	// Use `true` or `false` to indicate the preference. This field is immutable once set.
+	// Use `true` or `false` to indicate the preference.
 	// +kubebuilder:validation:Enum:="true";"false"
 	// +kubebuilder:default:="false"
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="injectAnnotations is immutable once set"
 	// +kubebuilder:validation:Optional
 	InjectAnnotations string `json:"injectAnnotations,omitempty"`
 
 	// issuerRef contains details of the referenced object used for obtaining certificates.
 	// When `issuerRef.Kind` is `Issuer`, it must exist in the `external-secrets` namespace.
-	// This field is immutable once set.
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="issuerRef is immutable once set"
+	// Changing the issuer has the webhook certificate reissued, which is tracked in `status.certificateMigration`.
 	// +kubebuilder:validation:XValidation:rule="!has(self.kind) || self.kind.lowerAscii() == 'issuer' || self.kind.lowerAscii() == 'clusterissuer'",message="kind must be either 'Issuer' or 'ClusterIssuer'"
 	// +kubebuilder:validation:XValidation:rule="!has(self.group) || self.group.lowerAscii() == 'cert-manager.io'",message="group must be 'cert-manager.io'"
 	// +kubebuilder:validation:Optional
@@ -196,6 +668,184 @@ type CertManagerConfig struct {
 	// +kubebuilder:default:="30m"
 	// +kubebuilder:validation:Optional
 	CertificateRenewBefore *metav1.Duration `json:"certificateRenewBefore,omitempty"`
+
+	// privateKey is for configuring the private key of the webhook and bitwarden-sdk-server certificates.
+	// +kubebuilder:validation:Optional
+	PrivateKey *CertificatePrivateKey `json:"privateKey,omitempty"`
+
+	// subject is the X.509 subject of the webhook and bitwarden-sdk-server certificates.
+	// +kubebuilder:validation:Optional
+	Subject *CertificateSubject `json:"subject,omitempty"`
+
+	// usages is the set of key usages and extended key usages requested for the webhook and bitwarden-sdk-server
+	// certificates, which must include `server auth`. cert-manager requests `digital signature` and `key encipherment`
+	// when not configured.
+	// This field can have a maximum of 10 entries.
+	// +listType=set
+	// +kubebuilder:validation:MaxItems:=10
+	// +kubebuilder:validation:XValidation:rule="self.exists(u, u == 'server auth')",message="usages must include 'server auth'"
+	// +kubebuilder:validation:Optional
+	Usages []KeyUsage `json:"usages,omitempty"`
+
+	// secretTemplate is for the labels and annotations to be added to the certificate secrets created by cert-manager.
+	// +kubebuilder:validation:Optional
+	SecretTemplate *CertificateSecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+// CertificatePrivateKey is for configuring the private key of the certificates issued by cert-manager.
+// +kubebuilder:validation:XValidation:rule="!has(self.size) || (has(self.algorithm) && self.algorithm != 'RSA') || (self.size >= 2048 && self.size <= 8192)",message="size must be between 2048 and 8192 for RSA algorithm"
+// +kubebuilder:validation:XValidation:rule="!has(self.size) || !has(self.algorithm) || self.algorithm != 'ECDSA' || self.size in [256, 384, 521]",message="size must be one of 256, 384 or 521 for ECDSA algorithm"
+// +kubebuilder:validation:XValidation:rule="!has(self.algorithm) || self.algorithm != 'Ed25519' || !has(self.size)",message="size must not be set for Ed25519 algorithm"
+// +kubebuilder:validation:XValidation:rule="!has(self.algorithm) || self.algorithm != 'Ed25519' || !has(self.encoding) || self.encoding == 'PKCS8'",message="encoding must be PKCS8 for Ed25519 algorithm"
+type CertificatePrivateKey struct {
+	// algorithm is the private key algorithm, which is RSA when not configured.
+	// +kubebuilder:validation:Enum:=RSA;ECDSA;Ed25519
+	// +kubebuilder:validation:Optional
+	Algorithm PrivateKeyAlgorithm `json:"algorithm,omitempty"`
+
+	// size is the private key size in bits, which is 2048 for RSA and 256 for ECDSA when not configured.
+	// Must be between 2048 and 8192 for RSA, one of 256, 384 or 521 for ECDSA, and not set for Ed25519.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Optional
+	Size int32 `json:"size,omitempty"`
+
+	// encoding is the encoding of the private key in the certificate secret, which is PKCS1 when not configured.
+	// The bitwarden-sdk-server certificate uses PKCS8 encoding when not configured.
+	// +kubebuilder:validation:Enum:=PKCS1;PKCS8
+	// +kubebuilder:validation:Optional
+	Encoding PrivateKeyEncoding `json:"encoding,omitempty"`
+
+	// rotationPolicy is for whether a new private key is generated on every issuance of the certificate.
+	// Never: The existing private key is reused, when it matches the configured algorithm and size.
+	// Always: A new private key is generated on every issuance.
+	// The default of cert-manager applies when not configured, which is Always from cert-manager v1.18.
+	// +kubebuilder:validation:Enum:=Never;Always
+	// +kubebuilder:validation:Optional
+	RotationPolicy PrivateKeyRotationPolicy `json:"rotationPolicy,omitempty"`
+}
+
+// PrivateKeyAlgorithm is the algorithm of the certificate private key.
+type PrivateKeyAlgorithm string
+
+const (
+	// RSAKeyAlgorithm is the RSA private key algorithm.
+	RSAKeyAlgorithm PrivateKeyAlgorithm = "RSA"
+
+	// ECDSAKeyAlgorithm is the ECDSA private key algorithm.
+	ECDSAKeyAlgorithm PrivateKeyAlgorithm = "ECDSA"
+
+	// Ed25519KeyAlgorithm is the Ed25519 private key algorithm.
+	Ed25519KeyAlgorithm PrivateKeyAlgorithm = "Ed25519"
+)
+
+// PrivateKeyEncoding is the encoding of the certificate private key.
+type PrivateKeyEncoding string
+
+const (
+	// PKCS1 is the PKCS#1 private key encoding.
+	PKCS1 PrivateKeyEncoding = "PKCS1"
+
+	// PKCS8 is the PKCS#8 private key encoding.
+	PKCS8 PrivateKeyEncoding = "PKCS8"
+)
+
+// PrivateKeyRotationPolicy is the policy for generating the certificate private key on issuance.
+type PrivateKeyRotationPolicy string
+
+const (
+	// RotationPolicyNever is for reusing the existing private key.
+	RotationPolicyNever PrivateKeyRotationPolicy = "Never"
+
+	// RotationPolicyAlways is for generating a new private key on every issuance.
+	RotationPolicyAlways PrivateKeyRotationPolicy = "Always"
+)
+
+// CertificateSubject is the X.509 subject of the certificates issued by cert-manager.
+type CertificateSubject struct {
+	// organizations to be used in the certificate subject.
+	// This field can have a maximum of 10 entries.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=10
+	// +kubebuilder:validation:items:MaxLength:=64
+	// +kubebuilder:validation:Optional
+	Organizations []string `json:"organizations,omitempty"`
+
+	// organizationalUnits to be used in the certificate subject.
+	// This field can have a maximum of 10 entries.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=10
+	// +kubebuilder:validation:items:MaxLength:=64
+	// +kubebuilder:validation:Optional
+	OrganizationalUnits []string `json:"organizationalUnits,omitempty"`
+
+	// countries to be used in the certificate subject.
+	// This field can have a maximum of 10 entries.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=10
+	// +kubebuilder:validation:items:MaxLength:=64
+	// +kubebuilder:validation:Optional
+	Countries []string `json:"countries,omitempty"`
+
+	// provinces to be used in the certificate subject.
+	// This field can have a maximum of 10 entries.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=10
+	// +kubebuilder:validation:items:MaxLength:=128
+	// +kubebuilder:validation:Optional
+	Provinces []string `json:"provinces,omitempty"`
+
+	// localities to be used in the certificate subject.
+	// This field can have a maximum of 10 entries.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=10
+	// +kubebuilder:validation:items:MaxLength:=128
+	// +kubebuilder:validation:Optional
+	Localities []string `json:"localities,omitempty"`
+
+	// streetAddresses to be used in the certificate subject.
+	// This field can have a maximum of 10 entries.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=10
+	// +kubebuilder:validation:items:MaxLength:=128
+	// +kubebuilder:validation:Optional
+	StreetAddresses []string `json:"streetAddresses,omitempty"`
+
+	// postalCodes to be used in the certificate subject.
+	// This field can have a maximum of 10 entries.
+	// +listType=atomic
+	// +kubebuilder:validation:MaxItems:=10
+	// +kubebuilder:validation:items:MaxLength:=40
+	// +kubebuilder:validation:Optional
+	PostalCodes []string `json:"postalCodes,omitempty"`
+
+	// serialNumber to be used in the certificate subject.
+	// +kubebuilder:validation:MaxLength:=64
+	// +kubebuilder:validation:Optional
+	SerialNumber string `json:"serialNumber,omitempty"`
+}
+
+// KeyUsage is a key usage or an extended key usage of the certificate, as supported by cert-manager.
+// +kubebuilder:validation:Enum:="signing";"digital signature";"content commitment";"key encipherment";"key agreement";"data encipherment";"server auth";"client auth"
+type KeyUsage string
+
+// CertificateSecretTemplate is for the metadata to be added to the certificate secrets created by cert-manager.
+type CertificateSecretTemplate struct {
+	// labels to be added to the certificate secrets. The labels reserved for the operand resources,
+	// like the ones with the `app.kubernetes.io/` prefix and the `app` label, are ignored.
+	// This field can have a maximum of 20 entries.
+	// +mapType=granular
+	// +kubebuilder:validation:MinProperties:=0
+	// +kubebuilder:validation:MaxProperties:=20
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// annotations to be added to the certificate secrets.
+	// This field can have a maximum of 20 entries.
+	// +mapType=granular
+	// +kubebuilder:validation:MinProperties:=0
+	// +kubebuilder:validation:MaxProperties:=20
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 // PluginsConfig is for configuring the optional plugins.
@@ -206,19 +856,43 @@ type PluginsConfig struct {
 }
 
 // CertProvidersConfig defines the configuration for certificate providers used to manage TLS certificates for webhook and plugins.
+// +kubebuilder:validation:XValidation:rule="!has(self.certManager) || !has(self.serviceCA) || self.certManager.mode != 'Enabled' || self.serviceCA.mode != 'Enabled'",message="certManager and serviceCA cannot be enabled together"
 type CertProvidersConfig struct {
 	// certManager is for configuring cert-manager provider specifics.
 	// +kubebuilder:validation:Optional
 	CertManager *CertManagerConfig `json:"certManager,omitempty"`
+
+	// serviceCA is for configuring OpenShift service-ca provider specifics.
+	// +kubebuilder:validation:Optional
+	ServiceCA *ServiceCAConfig `json:"serviceCA,omitempty"`
 }
 
-// ComponentName represents the different external-secrets components that can have network policies applied.
+// ServiceCAConfig is for configuring OpenShift service-ca specifics.
+type ServiceCAConfig struct {
+	// mode indicates whether to use the OpenShift service-ca operator for certificate management, instead of built-in cert-controller.
+	// Enabled: The webhook and bitwarden-sdk-server Services are annotated with `service.beta.openshift.io/serving-cert-secret-name`
+	// for the serving certificates to be generated, and the `service.beta.openshift.io/inject-cabundle` annotation is added to the
+	// ValidatingWebhookConfigurations and CRDs for the service CA to be injected. The cert-controller is not deployed.
+	// Disabled: Makes use of in-built cert-controller for obtaining the certificates for webhook server, which is the default behavior.
+	// +kubebuilder:validation:Enum:=Enabled;Disabled
+	// +kubebuilder:validation:Required
+	Mode Mode `json:"mode,omitempty"`
+}
+
+// ComponentName represents the different external-secrets components, which can have network policies applied
+// and can have their configurations overridden.
 type ComponentName string
 
 const (
 	// CoreController represents the external-secrets component
 	CoreController ComponentName = "ExternalSecretsCoreController"
 
+	// Webhook represents the external-secrets-webhook component
+	Webhook ComponentName = "Webhook"
+
+	// CertController represents the external-secrets-cert-controller component
+	CertController ComponentName = "CertController"
+
 	// BitwardenSDKServer represents the bitwarden-sdk-server component
 	BitwardenSDKServer ComponentName = "BitwardenSDKServer"
 )
@@ -113,6 +113,10 @@ type Condition struct {
 	// status of the condition
 	Status metav1.ConditionStatus `json:"status"`
 
+	// reason is the programmatic identifier of the cause for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
 	// message provides details about the state.
 	Message string `json:"message"`
 }
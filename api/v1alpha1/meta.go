@@ -45,6 +45,23 @@ type SecretReference struct {
 	Name string `json:"name"`
 }
 
+// ConfigMapKeyReference is a reference to a key in the ConfigMap with the given name, which should exist in the same namespace where it will be utilized.
+type ConfigMapKeyReference struct {
+	// Name of the ConfigMap resource being referred to.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=253
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Key in the ConfigMap resource being referred to.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=253
+	// +kubebuilder:validation:Pattern:=`^[-._a-zA-Z0-9]+$`
+	// +kubebuilder:default:="ca-bundle.crt"
+	// +kubebuilder:validation:Optional
+	Key string `json:"key,omitempty"`
+}
+
 // CommonConfigs are the common configurations available for all the operands managed by the operator.
 type CommonConfigs struct {
 	// logLevel supports value range as per [Kubernetes logging guidelines](https://github.com/kubernetes/community/blob/master/contributors/devel/sig-instrumentation/logging.md#what-method-to-use).
@@ -84,6 +101,8 @@ type CommonConfigs struct {
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
 
 	// proxy is for setting the proxy configurations which will be made available in operand containers managed by the operator as environment variables.
+	// The configuration in ExternalSecretsConfig takes precedence over the configuration in ExternalSecretsManager, and when
+	// neither is configured the OpenShift cluster-wide proxy configuration in `proxies.config.openshift.io/cluster` is used.
 	// +kubebuilder:validation:Optional
 	Proxy *ProxyConfig `json:"proxy,omitempty"`
 }
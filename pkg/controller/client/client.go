@@ -6,11 +6,20 @@ import (
 	"reflect"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
 	"k8s.io/client-go/util/retry"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 )
 
+// FieldManager is the name of the field manager used for applying the resources with server-side apply.
+const FieldManager = "external-secrets-operator"
+
 type CtrlClientImpl struct {
 	client.Client
 }
@@ -26,6 +35,8 @@ type CtrlClient interface {
 	Create(context.Context, client.Object, ...client.CreateOption) error
 	Delete(context.Context, client.Object, ...client.DeleteOption) error
 	Patch(context.Context, client.Object, client.Patch, ...client.PatchOption) error
+	Apply(context.Context, client.Object, ...client.PatchOption) error
+	UpgradeManagedFields(context.Context, client.Object) error
 	Exists(context.Context, client.ObjectKey, client.Object) (bool, error)
 }
 
@@ -65,6 +76,10 @@ func (c *CtrlClientImpl) UpdateWithRetry(
 	key := client.ObjectKeyFromObject(obj)
 	if err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		current := reflect.New(reflect.TypeOf(obj).Elem()).Interface().(client.Object)
+		// kind of the unstructured objects is known only from the object being updated.
+		if u, ok := obj.(*unstructured.Unstructured); ok {
+			current.(*unstructured.Unstructured).SetGroupVersionKind(u.GroupVersionKind())
+		}
 		if err := c.Client.Get(ctx, key, current); err != nil {
 			return fmt.Errorf("failed to fetch latest %q for update: %w", key, err)
 		}
@@ -92,6 +107,60 @@ func (c *CtrlClientImpl) Patch(
 	return c.Client.Patch(ctx, obj, patch, opts...)
 }
 
+// Apply applies the object with server-side apply as the FieldManager, forcing the ownership of the fields
+// conflicting with the other managers. Only the fields set in the object are owned, and the object is
+// updated with the state returned by the server.
+func (c *CtrlClientImpl) Apply(
+	ctx context.Context, obj client.Object, opts ...client.PatchOption,
+) error {
+	gvk, err := apiutil.GVKForObject(obj, c.Client.Scheme())
+	if err != nil {
+		return fmt.Errorf("failed to find kind of %q resource: %w", client.ObjectKeyFromObject(obj), err)
+	}
+	// apply request must have the kind set, and must not have the fields set only by the server.
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	obj.SetManagedFields(nil)
+	obj.SetResourceVersion("")
+
+	opts = append([]client.PatchOption{client.FieldOwner(FieldManager), client.ForceOwnership}, opts...)
+	return c.Client.Patch(ctx, obj, client.Apply, opts...)
+}
+
+// UpgradeManagedFields migrates the fields owned by the FieldManager with the Update operation, which were
+// written before the resources were reconciled with server-side apply, to the Apply operation. The fields
+// would otherwise remain owned by the Update manager, and would not be removed on being dropped from the
+// applied object. current is the existing resource, and is updated with the state returned by the server
+// when the migration is required.
+func (c *CtrlClientImpl) UpgradeManagedFields(ctx context.Context, current client.Object) error {
+	if !hasUpdateManagedFields(current) {
+		return nil
+	}
+
+	key := client.ObjectKeyFromObject(current)
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(current, sets.New(FieldManager), FieldManager)
+	if err != nil {
+		return fmt.Errorf("failed to compute managed fields migration of %q resource: %w", key, err)
+	}
+	if patch == nil {
+		return nil
+	}
+	if err := c.Client.Patch(ctx, current, client.RawPatch(types.JSONPatchType, patch)); err != nil {
+		return fmt.Errorf("failed to migrate managed fields of %q resource: %w", key, err)
+	}
+	return nil
+}
+
+// hasUpdateManagedFields returns whether any of the fields of the object are owned by the FieldManager
+// with the Update operation.
+func hasUpdateManagedFields(obj client.Object) bool {
+	for _, entry := range obj.GetManagedFields() {
+		if entry.Manager == FieldManager && entry.Operation == metav1.ManagedFieldsOperationUpdate {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *CtrlClientImpl) Exists(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
 	if err := c.Client.Get(ctx, key, obj); err != nil {
 		if errors.IsNotFound(err) {
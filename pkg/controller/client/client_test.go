@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testManagedFields = `{"f:data":{"f:key":{}}}`
+
+// stubClient records the patches sent, and fails the Get requests, since the existing resource must not be
+// fetched again for applying.
+type stubClient struct {
+	client.Client
+	patches []client.Patch
+}
+
+func (s *stubClient) Scheme() *runtime.Scheme {
+	return clientgoscheme.Scheme
+}
+
+func (s *stubClient) Get(_ context.Context, key client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+	return fmt.Errorf("unexpected get of %q resource", key)
+}
+
+func (s *stubClient) Patch(_ context.Context, _ client.Object, patch client.Patch, _ ...client.PatchOption) error {
+	s.patches = append(s.patches, patch)
+	return nil
+}
+
+func testConfigMap(managedFields ...metav1.ManagedFieldsEntry) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "test",
+			Namespace:       "external-secrets",
+			ResourceVersion: "1",
+			ManagedFields:   managedFields,
+		},
+		Data: map[string]string{"key": "value"},
+	}
+}
+
+func testManagedFieldsEntry(manager string, operation metav1.ManagedFieldsOperationType) metav1.ManagedFieldsEntry {
+	return metav1.ManagedFieldsEntry{
+		Manager:    manager,
+		Operation:  operation,
+		APIVersion: "v1",
+		FieldsType: "FieldsV1",
+		FieldsV1:   &metav1.FieldsV1{Raw: []byte(testManagedFields)},
+	}
+}
+
+func TestApply(t *testing.T) {
+	stub := &stubClient{}
+	c := &CtrlClientImpl{Client: stub}
+
+	obj := testConfigMap(testManagedFieldsEntry(FieldManager, metav1.ManagedFieldsOperationApply))
+	if err := c.Apply(context.Background(), obj); err != nil {
+		t.Fatalf("Apply() unexpected error: %v", err)
+	}
+	if len(stub.patches) != 1 {
+		t.Fatalf("Apply() sent %d patches, want 1", len(stub.patches))
+	}
+	if stub.patches[0].Type() != types.ApplyPatchType {
+		t.Errorf("Apply() patch type = %v, want %v", stub.patches[0].Type(), types.ApplyPatchType)
+	}
+	if obj.GetManagedFields() != nil || obj.GetResourceVersion() != "" {
+		t.Errorf("Apply() sent server set fields, managedFields: %v, resourceVersion: %q", obj.GetManagedFields(), obj.GetResourceVersion())
+	}
+}
+
+func TestUpgradeManagedFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		current *corev1.ConfigMap
+		// wantManagedFields is the managed fields expected to be patched, nil when no migration is expected.
+		wantManagedFields []metav1.ManagedFieldsEntry
+	}{
+		{
+			name:    "resource managed with update operation is migrated",
+			current: testConfigMap(testManagedFieldsEntry(FieldManager, metav1.ManagedFieldsOperationUpdate)),
+			wantManagedFields: []metav1.ManagedFieldsEntry{
+				testManagedFieldsEntry(FieldManager, metav1.ManagedFieldsOperationApply),
+			},
+		},
+		{
+			name: "fields of other managers are retained on migrating",
+			current: testConfigMap(
+				testManagedFieldsEntry(FieldManager, metav1.ManagedFieldsOperationUpdate),
+				testManagedFieldsEntry("kubectl", metav1.ManagedFieldsOperationUpdate),
+			),
+			wantManagedFields: []metav1.ManagedFieldsEntry{
+				testManagedFieldsEntry(FieldManager, metav1.ManagedFieldsOperationApply),
+				testManagedFieldsEntry("kubectl", metav1.ManagedFieldsOperationUpdate),
+			},
+		},
+		{
+			name:    "resource already managed with apply operation",
+			current: testConfigMap(testManagedFieldsEntry(FieldManager, metav1.ManagedFieldsOperationApply)),
+		},
+		{
+			name:    "resource not managed by the operator",
+			current: testConfigMap(testManagedFieldsEntry("kubectl", metav1.ManagedFieldsOperationUpdate)),
+		},
+		{
+			name:    "resource without managed fields",
+			current: testConfigMap(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubClient{}
+			c := &CtrlClientImpl{Client: stub}
+			current := tt.current.DeepCopy()
+
+			if err := c.UpgradeManagedFields(context.Background(), current); err != nil {
+				t.Fatalf("UpgradeManagedFields() unexpected error: %v", err)
+			}
+
+			if tt.wantManagedFields == nil {
+				if len(stub.patches) != 0 {
+					t.Errorf("UpgradeManagedFields() sent %d patches, want 0", len(stub.patches))
+				}
+				return
+			}
+			if len(stub.patches) != 1 {
+				t.Fatalf("UpgradeManagedFields() sent %d patches, want 1", len(stub.patches))
+			}
+
+			migration := stub.patches[0]
+			if migration.Type() != types.JSONPatchType {
+				t.Fatalf("UpgradeManagedFields() patch type = %v, want %v", migration.Type(), types.JSONPatchType)
+			}
+			data, err := migration.Data(tt.current)
+			if err != nil {
+				t.Fatalf("failed to read migration patch: %v", err)
+			}
+			var ops []struct {
+				Op    string          `json:"op"`
+				Path  string          `json:"path"`
+				Value json.RawMessage `json:"value"`
+			}
+			if err := json.Unmarshal(data, &ops); err != nil {
+				t.Fatalf("failed to decode migration patch %s: %v", data, err)
+			}
+			var gotManagedFields []metav1.ManagedFieldsEntry
+			for _, op := range ops {
+				if op.Path == "/metadata/managedFields" {
+					if err := json.Unmarshal(op.Value, &gotManagedFields); err != nil {
+						t.Fatalf("failed to decode managed fields %s: %v", op.Value, err)
+					}
+				}
+			}
+			wantData, _ := json.Marshal(tt.wantManagedFields)
+			gotData, _ := json.Marshal(gotManagedFields)
+			if string(gotData) != string(wantData) {
+				t.Errorf("UpgradeManagedFields() migrated managed fields = %s, want %s", gotData, wantData)
+			}
+		})
+	}
+}
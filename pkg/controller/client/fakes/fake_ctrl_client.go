@@ -10,6 +10,19 @@ import (
 )
 
 type FakeCtrlClient struct {
+	ApplyStub        func(context.Context, clienta.Object, ...clienta.PatchOption) error
+	applyMutex       sync.RWMutex
+	applyArgsForCall []struct {
+		arg1 context.Context
+		arg2 clienta.Object
+		arg3 []clienta.PatchOption
+	}
+	applyReturns struct {
+		result1 error
+	}
+	applyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CreateStub        func(context.Context, clienta.Object, ...clienta.CreateOption) error
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
@@ -130,10 +143,85 @@ type FakeCtrlClient struct {
 	updateWithRetryReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpgradeManagedFieldsStub        func(context.Context, clienta.Object) error
+	upgradeManagedFieldsMutex       sync.RWMutex
+	upgradeManagedFieldsArgsForCall []struct {
+		arg1 context.Context
+		arg2 clienta.Object
+	}
+	upgradeManagedFieldsReturns struct {
+		result1 error
+	}
+	upgradeManagedFieldsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeCtrlClient) Apply(arg1 context.Context, arg2 clienta.Object, arg3 ...clienta.PatchOption) error {
+	fake.applyMutex.Lock()
+	ret, specificReturn := fake.applyReturnsOnCall[len(fake.applyArgsForCall)]
+	fake.applyArgsForCall = append(fake.applyArgsForCall, struct {
+		arg1 context.Context
+		arg2 clienta.Object
+		arg3 []clienta.PatchOption
+	}{arg1, arg2, arg3})
+	stub := fake.ApplyStub
+	fakeReturns := fake.applyReturns
+	fake.recordInvocation("Apply", []interface{}{arg1, arg2, arg3})
+	fake.applyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCtrlClient) ApplyCallCount() int {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return len(fake.applyArgsForCall)
+}
+
+func (fake *FakeCtrlClient) ApplyCalls(stub func(context.Context, clienta.Object, ...clienta.PatchOption) error) {
+	fake.applyMutex.Lock()
+	defer fake.applyMutex.Unlock()
+	fake.ApplyStub = stub
+}
+
+func (fake *FakeCtrlClient) ApplyArgsForCall(i int) (context.Context, clienta.Object, []clienta.PatchOption) {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	argsForCall := fake.applyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCtrlClient) ApplyReturns(result1 error) {
+	fake.applyMutex.Lock()
+	defer fake.applyMutex.Unlock()
+	fake.ApplyStub = nil
+	fake.applyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCtrlClient) ApplyReturnsOnCall(i int, result1 error) {
+	fake.applyMutex.Lock()
+	defer fake.applyMutex.Unlock()
+	fake.ApplyStub = nil
+	if fake.applyReturnsOnCall == nil {
+		fake.applyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.applyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCtrlClient) Create(arg1 context.Context, arg2 clienta.Object, arg3 ...clienta.CreateOption) error {
 	fake.createMutex.Lock()
 	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
@@ -705,9 +793,73 @@ func (fake *FakeCtrlClient) UpdateWithRetryReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeCtrlClient) UpgradeManagedFields(arg1 context.Context, arg2 clienta.Object) error {
+	fake.upgradeManagedFieldsMutex.Lock()
+	ret, specificReturn := fake.upgradeManagedFieldsReturnsOnCall[len(fake.upgradeManagedFieldsArgsForCall)]
+	fake.upgradeManagedFieldsArgsForCall = append(fake.upgradeManagedFieldsArgsForCall, struct {
+		arg1 context.Context
+		arg2 clienta.Object
+	}{arg1, arg2})
+	stub := fake.UpgradeManagedFieldsStub
+	fakeReturns := fake.upgradeManagedFieldsReturns
+	fake.recordInvocation("UpgradeManagedFields", []interface{}{arg1, arg2})
+	fake.upgradeManagedFieldsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCtrlClient) UpgradeManagedFieldsCallCount() int {
+	fake.upgradeManagedFieldsMutex.RLock()
+	defer fake.upgradeManagedFieldsMutex.RUnlock()
+	return len(fake.upgradeManagedFieldsArgsForCall)
+}
+
+func (fake *FakeCtrlClient) UpgradeManagedFieldsCalls(stub func(context.Context, clienta.Object) error) {
+	fake.upgradeManagedFieldsMutex.Lock()
+	defer fake.upgradeManagedFieldsMutex.Unlock()
+	fake.UpgradeManagedFieldsStub = stub
+}
+
+func (fake *FakeCtrlClient) UpgradeManagedFieldsArgsForCall(i int) (context.Context, clienta.Object) {
+	fake.upgradeManagedFieldsMutex.RLock()
+	defer fake.upgradeManagedFieldsMutex.RUnlock()
+	argsForCall := fake.upgradeManagedFieldsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCtrlClient) UpgradeManagedFieldsReturns(result1 error) {
+	fake.upgradeManagedFieldsMutex.Lock()
+	defer fake.upgradeManagedFieldsMutex.Unlock()
+	fake.UpgradeManagedFieldsStub = nil
+	fake.upgradeManagedFieldsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCtrlClient) UpgradeManagedFieldsReturnsOnCall(i int, result1 error) {
+	fake.upgradeManagedFieldsMutex.Lock()
+	defer fake.upgradeManagedFieldsMutex.Unlock()
+	fake.UpgradeManagedFieldsStub = nil
+	if fake.upgradeManagedFieldsReturnsOnCall == nil {
+		fake.upgradeManagedFieldsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.upgradeManagedFieldsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeCtrlClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
 	fake.deleteMutex.RLock()
@@ -726,6 +878,8 @@ func (fake *FakeCtrlClient) Invocations() map[string][][]interface{} {
 	defer fake.updateMutex.RUnlock()
 	fake.updateWithRetryMutex.RLock()
 	defer fake.updateWithRetryMutex.RUnlock()
+	fake.upgradeManagedFieldsMutex.RLock()
+	defer fake.upgradeManagedFieldsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
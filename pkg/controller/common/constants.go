@@ -20,10 +20,30 @@ const (
 	// after successful reconciliation by the controller.
 	CertManagerInjectCAFromAnnotation = "cert-manager.io/inject-ca-from"
 
-	// CertManagerInjectCAFromAnnotationValue is the annotation value added to external-secrets resource once
-	// if certManager field is enabled in webhook config
-	// after successful reconciliation by the controller.
-	CertManagerInjectCAFromAnnotationValue = "external-secrets/external-secrets-webhook"
+	// ServiceCAInjectCABundleAnnotation is the annotation key added to external-secrets resources for the
+	// OpenShift service-ca operator to inject the service CA bundle, when serviceCA field is enabled.
+	ServiceCAInjectCABundleAnnotation = "service.beta.openshift.io/inject-cabundle"
+
+	// ServiceCAServingCertSecretNameAnnotation is the annotation key added to the Services for the OpenShift
+	// service-ca operator to generate the serving certificate in the named secret, when serviceCA field is enabled.
+	ServiceCAServingCertSecretNameAnnotation = "service.beta.openshift.io/serving-cert-secret-name"
+
+	// certManagerWebhookCertificateName is the name of the cert-manager Certificate created for the webhook,
+	// which is referred in the cert-manager.io/inject-ca-from annotation value.
+	certManagerWebhookCertificateName = "external-secrets-webhook"
+
+	// ExternalSecretsDefaultNamespace is the namespace where the `external-secrets` operand required resources
+	// will be created, when ExternalSecretsConfig.Spec.ApplicationConfig.Namespace is not set.
+	ExternalSecretsDefaultNamespace = "external-secrets"
+
+	// ConfigOverridesHashAnnotation is the annotation key added to the resources patched with the unsupportedConfigOverrides
+	// configured in ExternalSecretsConfig, whose value is the hash of the patches applied, for identifying the revision
+	// of the overrides a resource was last applied with.
+	ConfigOverridesHashAnnotation = "operator.openshift.io/unsupported-config-overrides-hash"
+
+	// DesiredStateHashAnnotation is the annotation key added to the resources applied by the controller, whose value
+	// is the hash of the desired state of the resource, for skipping the apply when the desired state is unchanged.
+	DesiredStateHashAnnotation = "operator.openshift.io/desired-state-hash"
 
 	// ExternalSecretsOperatorCommonName is the name commonly used for labelling resources.
 	ExternalSecretsOperatorCommonName = "external-secrets-operator"
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
 )
 
 type ErrorReason string
@@ -15,8 +17,23 @@ const (
 	RetryRequiredError ErrorReason = "RetryRequiredError"
 )
 
+// ErrorCause is the category of the failure, which is reported as the reason
+// in the status conditions for identifying what is broken.
+type ErrorCause string
+
+const (
+	IssuerNotFound ErrorCause = ErrorCause(operatorv1alpha1.ReasonIssuerNotFound)
+
+	InvalidConfiguration ErrorCause = ErrorCause(operatorv1alpha1.ReasonInvalidConfiguration)
+
+	APIConflict ErrorCause = ErrorCause(operatorv1alpha1.ReasonAPIConflict)
+
+	PermissionDenied ErrorCause = ErrorCause(operatorv1alpha1.ReasonPermissionDenied)
+)
+
 type ReconcileError struct {
 	Reason  ErrorReason `json:"reason,omitempty"`
+	Cause   ErrorCause  `json:"cause,omitempty"`
 	Message string      `json:"message,omitempty"`
 	Err     error       `json:"error,omitempty"`
 }
@@ -52,19 +69,55 @@ func IsIrrecoverableError(err error) bool {
 	return false
 }
 
+// GetErrorCause returns the cause of the first error in the chain for which it is set.
+func GetErrorCause(err error) ErrorCause {
+	for err != nil {
+		if rerr, ok := err.(*ReconcileError); ok && rerr != nil && rerr.Cause != "" {
+			return rerr.Cause
+		}
+		err = errors.Unwrap(err)
+	}
+	return ""
+}
+
+// WithCause sets the cause of the error.
+func (e *ReconcileError) WithCause(cause ErrorCause) *ReconcileError {
+	if e != nil {
+		e.Cause = cause
+	}
+	return e
+}
+
 // ReconcileError implements the ReconcileError interface.
 func (e *ReconcileError) Error() string {
 	return fmt.Sprintf("%s: %s", e.Message, e.Err)
 }
 
+func (e *ReconcileError) Unwrap() error {
+	return e.Err
+}
+
 func FromClientError(err error, message string, args ...any) *ReconcileError {
 	if err == nil {
 		return nil
 	}
 	if apierrors.IsUnauthorized(err) || apierrors.IsForbidden(err) || apierrors.IsInvalid(err) ||
 		apierrors.IsBadRequest(err) || apierrors.IsServiceUnavailable(err) {
-		return NewIrrecoverableError(err, message, args...)
+		return NewIrrecoverableError(err, message, args...).WithCause(clientErrorCause(err))
 	}
 
-	return NewRetryRequiredError(err, message, args...)
+	return NewRetryRequiredError(err, message, args...).WithCause(clientErrorCause(err))
+}
+
+// clientErrorCause returns the cause for the errors returned by the API server.
+func clientErrorCause(err error) ErrorCause {
+	switch {
+	case apierrors.IsUnauthorized(err) || apierrors.IsForbidden(err):
+		return PermissionDenied
+	case apierrors.IsInvalid(err) || apierrors.IsBadRequest(err):
+		return InvalidConfiguration
+	case apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err):
+		return APIConflict
+	}
+	return ""
 }
@@ -11,10 +11,13 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	crdv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -46,6 +49,9 @@ func init() {
 	if err := networkingv1.AddToScheme(scheme); err != nil {
 		panic(err)
 	}
+	if err := policyv1.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
 	if err := rbacv1.AddToScheme(scheme); err != nil {
 		panic(err)
 	}
@@ -95,6 +101,14 @@ func DecodeClusterRoleBindingObjBytes(objBytes []byte) *rbacv1.ClusterRoleBindin
 	return obj.(*rbacv1.ClusterRoleBinding)
 }
 
+func DecodeConfigMapObjBytes(objBytes []byte) *corev1.ConfigMap {
+	obj, err := runtime.Decode(codecs.UniversalDecoder(corev1.SchemeGroupVersion), objBytes)
+	if err != nil {
+		panic(err)
+	}
+	return obj.(*corev1.ConfigMap)
+}
+
 func DecodeDeploymentObjBytes(objBytes []byte) *appsv1.Deployment {
 	obj, err := runtime.Decode(codecs.UniversalDecoder(appsv1.SchemeGroupVersion), objBytes)
 	if err != nil {
@@ -159,246 +173,38 @@ func DecodeNetworkPolicyObjBytes(objBytes []byte) *networkingv1.NetworkPolicy {
 	return obj.(*networkingv1.NetworkPolicy)
 }
 
-func HasObjectChanged(desired, fetched client.Object) bool {
-	if reflect.TypeOf(desired) != reflect.TypeOf(fetched) {
-		panic("both objects to be compared must be of same type")
-	}
-
-	var objectModified bool
-	switch desired.(type) {
-	case *certmanagerv1.Certificate:
-		objectModified = certificateSpecModified(desired.(*certmanagerv1.Certificate), fetched.(*certmanagerv1.Certificate))
-	case *rbacv1.ClusterRole:
-		objectModified = rbacRoleRulesModified[*rbacv1.ClusterRole](desired.(*rbacv1.ClusterRole), fetched.(*rbacv1.ClusterRole))
-	case *rbacv1.ClusterRoleBinding:
-		objectModified = rbacRoleBindingRefModified[*rbacv1.ClusterRoleBinding](desired.(*rbacv1.ClusterRoleBinding), fetched.(*rbacv1.ClusterRoleBinding)) ||
-			rbacRoleBindingSubjectsModified[*rbacv1.ClusterRoleBinding](desired.(*rbacv1.ClusterRoleBinding), fetched.(*rbacv1.ClusterRoleBinding))
-	case *appsv1.Deployment:
-		objectModified = deploymentSpecModified(desired.(*appsv1.Deployment), fetched.(*appsv1.Deployment))
-	case *rbacv1.Role:
-		objectModified = rbacRoleRulesModified[*rbacv1.Role](desired.(*rbacv1.Role), fetched.(*rbacv1.Role))
-	case *rbacv1.RoleBinding:
-		objectModified = rbacRoleBindingRefModified[*rbacv1.RoleBinding](desired.(*rbacv1.RoleBinding), fetched.(*rbacv1.RoleBinding)) ||
-			rbacRoleBindingSubjectsModified[*rbacv1.RoleBinding](desired.(*rbacv1.RoleBinding), fetched.(*rbacv1.RoleBinding))
-	case *corev1.Service:
-		objectModified = serviceSpecModified(desired.(*corev1.Service), fetched.(*corev1.Service))
-	case *networkingv1.NetworkPolicy:
-		objectModified = networkPolicySpecModified(desired.(*networkingv1.NetworkPolicy), fetched.(*networkingv1.NetworkPolicy))
-	case *webhook.ValidatingWebhookConfiguration:
-		objectModified = validatingWebHookSpecModified(desired.(*webhook.ValidatingWebhookConfiguration), fetched.(*webhook.ValidatingWebhookConfiguration))
-	default:
-		panic(fmt.Sprintf("unsupported object type: %T", desired))
-	}
-	return objectModified || ObjectMetadataModified(desired, fetched)
-}
-
-func ObjectMetadataModified(desired, fetched client.Object) bool {
-	return !reflect.DeepEqual(desired.GetLabels(), fetched.GetLabels())
-}
-
-func certificateSpecModified(desired, fetched *certmanagerv1.Certificate) bool {
-	return !reflect.DeepEqual(desired.Spec, fetched.Spec)
-}
-
-func deploymentSpecModified(desired, fetched *appsv1.Deployment) bool {
-	if desired.Spec.Replicas != nil && !reflect.DeepEqual(desired.Spec.Replicas, fetched.Spec.Replicas) {
-		return true
-	}
-
-	if desired.Spec.Template.Spec.ServiceAccountName != fetched.Spec.Template.Spec.ServiceAccountName ||
-		desired.Spec.Template.Spec.AutomountServiceAccountToken != nil {
-		if !reflect.DeepEqual(desired.Spec.Template.Spec.AutomountServiceAccountToken, fetched.Spec.Template.Spec.AutomountServiceAccountToken) {
-			return true
-		}
-	}
-
-	if desired.Spec.Template.Spec.DNSPolicy != "" && desired.Spec.Template.Spec.DNSPolicy != fetched.Spec.Template.Spec.DNSPolicy {
-		return true
-	}
-
-	if desired.Spec.Template.Labels != nil && !reflect.DeepEqual(desired.Spec.Template.Labels, fetched.Spec.Template.Labels) {
-		return true
-	}
-
-	if desired.Spec.Template.Spec.Volumes != nil && len(desired.Spec.Template.Spec.Volumes) != len(fetched.Spec.Template.Spec.Volumes) {
-		return true
-	}
-	for _, desiredVolume := range desired.Spec.Template.Spec.Volumes {
-		if desiredVolume.Secret != nil {
-			for _, fetchedVolume := range fetched.Spec.Template.Spec.Volumes {
-				if desiredVolume.Name == fetchedVolume.Name {
-					if !reflect.DeepEqual(desiredVolume.Secret.Items, fetchedVolume.Secret.Items) {
-						return true
-					}
-					if !reflect.DeepEqual(desiredVolume.Secret.SecretName, fetchedVolume.Secret.SecretName) {
-						return true
-					}
-				}
-			}
-		}
-	}
-
-	if desired.Spec.Template.Spec.NodeSelector != nil && !reflect.DeepEqual(desired.Spec.Template.Spec.NodeSelector, fetched.Spec.Template.Spec.NodeSelector) {
-		return true
-	}
-
-	if desired.Spec.Template.Spec.Affinity != nil && !reflect.DeepEqual(desired.Spec.Template.Spec.Affinity, fetched.Spec.Template.Spec.Affinity) {
-		return true
-	}
-
-	if desired.Spec.Template.Spec.Tolerations != nil && !reflect.DeepEqual(desired.Spec.Template.Spec.Tolerations, fetched.Spec.Template.Spec.Tolerations) {
-		return true
-	}
-
-	if len(desired.Spec.Template.Spec.Containers) != len(fetched.Spec.Template.Spec.Containers) {
-		return true
-	}
-
-	desiredContainer := desired.Spec.Template.Spec.Containers[0]
-	fetchedContainer := fetched.Spec.Template.Spec.Containers[0]
-
-	if !reflect.DeepEqual(desiredContainer.Args, fetchedContainer.Args) ||
-		desiredContainer.Name != fetchedContainer.Name ||
-		desiredContainer.Image != fetchedContainer.Image ||
-		desiredContainer.ImagePullPolicy != fetchedContainer.ImagePullPolicy {
-		return true
-	}
-
-	if len(desiredContainer.Ports) != len(fetchedContainer.Ports) {
-		return true
-	}
-	for _, fetchedPort := range fetchedContainer.Ports {
-		matched := false
-		for _, desiredPort := range desiredContainer.Ports {
-			if fetchedPort.ContainerPort == desiredPort.ContainerPort {
-				matched = true
-				break
-			}
-		}
-		if !matched {
-			return true
-		}
-	}
-
-	// ReadinessProbe nil checks
-	if (desiredContainer.ReadinessProbe == nil) != (fetchedContainer.ReadinessProbe == nil) {
-		return true
-	}
-	if desiredContainer.ReadinessProbe != nil && fetchedContainer.ReadinessProbe != nil {
-		if (desiredContainer.ReadinessProbe.HTTPGet == nil) != (fetchedContainer.ReadinessProbe.HTTPGet == nil) {
-			return true
-		}
-		if desiredContainer.ReadinessProbe.HTTPGet != nil && fetchedContainer.ReadinessProbe.HTTPGet != nil {
-			if desiredContainer.ReadinessProbe.HTTPGet.Path != fetchedContainer.ReadinessProbe.HTTPGet.Path {
-				return true
-			}
-		}
-	}
-
-	// SecurityContext nil check
-	if desiredContainer.SecurityContext != nil && !reflect.DeepEqual(*desiredContainer.SecurityContext, *fetchedContainer.SecurityContext) {
-		return true
-	}
-
-	if desiredContainer.VolumeMounts != nil && !reflect.DeepEqual(desiredContainer.VolumeMounts, fetchedContainer.VolumeMounts) {
-		return true
-	}
-
-	if reflect.DeepEqual(desiredContainer.Resources, corev1.ResourceRequirements{}) &&
-		!reflect.DeepEqual(desiredContainer.Resources, fetchedContainer.Resources) {
-		return true
-	}
-
-	return false
-}
-
-func serviceSpecModified(desired, fetched *corev1.Service) bool {
-	if desired.Spec.Type != fetched.Spec.Type ||
-		!reflect.DeepEqual(desired.Spec.Ports, fetched.Spec.Ports) ||
-		!reflect.DeepEqual(desired.Spec.Selector, fetched.Spec.Selector) {
-		return true
-	}
-
-	return false
-}
-
-func networkPolicySpecModified(desired, fetched *networkingv1.NetworkPolicy) bool {
-	if !reflect.DeepEqual(desired.Spec.PodSelector, fetched.Spec.PodSelector) ||
-		!reflect.DeepEqual(desired.Spec.PolicyTypes, fetched.Spec.PolicyTypes) ||
-		!reflect.DeepEqual(desired.Spec.Ingress, fetched.Spec.Ingress) ||
-		!reflect.DeepEqual(desired.Spec.Egress, fetched.Spec.Egress) {
-		return true
-	}
-
-	return false
-}
-
-func rbacRoleRulesModified[Object *rbacv1.Role | *rbacv1.ClusterRole](desired, fetched Object) bool {
-	switch typ := any(desired).(type) {
-	case *rbacv1.ClusterRole:
-		return !reflect.DeepEqual(any(desired).(*rbacv1.ClusterRole).Rules, any(fetched).(*rbacv1.ClusterRole).Rules)
-	case *rbacv1.Role:
-		return !reflect.DeepEqual(any(desired).(*rbacv1.Role).Rules, any(fetched).(*rbacv1.Role).Rules)
-	default:
-		panic(fmt.Sprintf("unsupported object type %v", typ))
+func DecodePodDisruptionBudgetObjBytes(objBytes []byte) *policyv1.PodDisruptionBudget {
+	obj, err := runtime.Decode(codecs.UniversalDecoder(policyv1.SchemeGroupVersion), objBytes)
+	if err != nil {
+		panic(err)
 	}
+	return obj.(*policyv1.PodDisruptionBudget)
 }
 
-func rbacRoleBindingRefModified[Object *rbacv1.RoleBinding | *rbacv1.ClusterRoleBinding](desired, fetched Object) bool {
-	switch typ := any(desired).(type) {
-	case *rbacv1.ClusterRoleBinding:
-		return !reflect.DeepEqual(any(desired).(*rbacv1.ClusterRoleBinding).RoleRef, any(fetched).(*rbacv1.ClusterRoleBinding).RoleRef)
-	case *rbacv1.RoleBinding:
-		return !reflect.DeepEqual(any(desired).(*rbacv1.RoleBinding).RoleRef, any(fetched).(*rbacv1.RoleBinding).RoleRef)
-	default:
-		panic(fmt.Sprintf("unsupported object type %v", typ))
+// DecodeUnstructuredObjBytes decodes the objects of the kinds not registered in the scheme, like the
+// prometheus-operator resources, whose API is not vendored.
+func DecodeUnstructuredObjBytes(objBytes []byte) *unstructured.Unstructured {
+	jsonBytes, err := utilyaml.ToJSON(objBytes)
+	if err != nil {
+		panic(err)
 	}
-}
-
-func rbacRoleBindingSubjectsModified[Object *rbacv1.RoleBinding | *rbacv1.ClusterRoleBinding](desired, fetched Object) bool {
-	switch typ := any(desired).(type) {
-	case *rbacv1.ClusterRoleBinding:
-		return !reflect.DeepEqual(any(desired).(*rbacv1.ClusterRoleBinding).Subjects, any(fetched).(*rbacv1.ClusterRoleBinding).Subjects)
-	case *rbacv1.RoleBinding:
-		return !reflect.DeepEqual(any(desired).(*rbacv1.RoleBinding).Subjects, any(fetched).(*rbacv1.RoleBinding).Subjects)
-	default:
-		panic(fmt.Sprintf("unsupported object type %v", typ))
+	obj, err := runtime.Decode(unstructured.UnstructuredJSONScheme, jsonBytes)
+	if err != nil {
+		panic(err)
 	}
+	return obj.(*unstructured.Unstructured)
 }
 
-func validatingWebHookSpecModified(desired, fetched *webhook.ValidatingWebhookConfiguration) bool {
-	if len(desired.Webhooks) != len(fetched.Webhooks) {
-		return true
+// ObjectKind returns the kind of the object, which is looked up in the scheme when not set in the object.
+func ObjectKind(obj client.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
 	}
-
-	if desiredVal, exists := desired.GetAnnotations()[CertManagerInjectCAFromAnnotation]; exists {
-		if desiredVal != fetched.GetAnnotations()[CertManagerInjectCAFromAnnotation] {
-			return true
-		}
-	}
-
-	fetchedWebhooksMap := make(map[string]webhook.ValidatingWebhook)
-	for _, wh := range fetched.Webhooks {
-		fetchedWebhooksMap[wh.Name] = wh
-	}
-
-	for _, desiredWh := range desired.Webhooks {
-		fetchedWh, ok := fetchedWebhooksMap[desiredWh.Name]
-		if !ok {
-			return true
-		}
-
-		if !reflect.DeepEqual(desiredWh.SideEffects, fetchedWh.SideEffects) ||
-			!reflect.DeepEqual(desiredWh.TimeoutSeconds, fetchedWh.TimeoutSeconds) ||
-			!reflect.DeepEqual(desiredWh.AdmissionReviewVersions, fetchedWh.AdmissionReviewVersions) ||
-			!reflect.DeepEqual(desiredWh.ClientConfig.Service.Name, fetchedWh.ClientConfig.Service.Name) ||
-			!reflect.DeepEqual(desiredWh.ClientConfig.Service.Path, fetchedWh.ClientConfig.Service.Path) ||
-			!reflect.DeepEqual(desiredWh.Rules, fetchedWh.Rules) {
-			return true
-		}
+	gvks, _, err := scheme.ObjectKinds(obj)
+	if err != nil || len(gvks) == 0 {
+		return ""
 	}
-
-	return false
+	return gvks[0].Kind
 }
 
 // ParseBool is for parsing a string value as a boolean value. This is very specific to the values
@@ -426,6 +232,28 @@ func IsInjectCertManagerAnnotationEnabled(esc *operatorv1alpha1.ExternalSecretsC
 		ParseBool(esc.Spec.ControllerConfig.CertProvider.CertManager.InjectAnnotations)
 }
 
+// IsServiceCAConfigEnabled returns whether the OpenShift service-ca provider is enabled in ExternalSecretsConfig CR Spec.
+func IsServiceCAConfigEnabled(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	return esc.Spec.ControllerConfig.CertProvider != nil &&
+		esc.Spec.ControllerConfig.CertProvider.ServiceCA != nil &&
+		EvalMode(esc.Spec.ControllerConfig.CertProvider.ServiceCA.Mode)
+}
+
+// GetExternalSecretsNamespace returns the namespace where the `external-secrets` operand resources are created.
+func GetExternalSecretsNamespace(esc *operatorv1alpha1.ExternalSecretsConfig) string {
+	if esc.Spec.ApplicationConfig.Namespace != "" {
+		return esc.Spec.ApplicationConfig.Namespace
+	}
+	return ExternalSecretsDefaultNamespace
+}
+
+// GetCertManagerInjectCAFromAnnotationValue returns the cert-manager.io/inject-ca-from annotation value added to the
+// external-secrets resources, when injectAnnotations is enabled in the certManager config, which refers to the
+// webhook Certificate in the operand namespace.
+func GetCertManagerInjectCAFromAnnotationValue(esc *operatorv1alpha1.ExternalSecretsConfig) string {
+	return fmt.Sprintf("%s/%s", GetExternalSecretsNamespace(esc), certManagerWebhookCertificateName)
+}
+
 // AddFinalizer adds finalizer to the passed resource object.
 func AddFinalizer(ctx context.Context, obj client.Object, opClient operatorclient.CtrlClient, finalizer string) error {
 	namespacedName := client.ObjectKeyFromObject(obj)
@@ -18,6 +18,7 @@ package crd_annotator
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	crdv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -29,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -203,10 +205,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, fmt.Errorf("failed to fetch externalsecretsconfigs.operator.openshift.io %q during reconciliation: %w", key, err)
 	}
 
-	if common.IsInjectCertManagerAnnotationEnabled(esc) {
+	if common.IsInjectCertManagerAnnotationEnabled(esc) || common.IsServiceCAConfigEnabled(esc) {
 		return r.processReconcileRequest(esc, req.NamespacedName)
 	}
 
+	// annotations added for the previously configured certificate provider are removed.
+	if err := r.updateAnnotationsInAllCRDs(esc); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed while removing annotations in all CRDs: %w", err)
+	}
 	return ctrl.Result{}, nil
 }
 
@@ -214,7 +220,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 func (r *Reconciler) processReconcileRequest(esc *operatorv1alpha1.ExternalSecretsConfig, req types.NamespacedName) (ctrl.Result, error) {
 	var oErr error = nil
 	if req.Name == reconcileObjectIdentifier {
-		if err := r.updateAnnotationsInAllCRDs(); err != nil {
+		if err := r.updateAnnotationsInAllCRDs(esc); err != nil {
 			oErr = fmt.Errorf("failed while updating annotations in all CRDs: %w", err)
 		}
 	} else {
@@ -228,7 +234,7 @@ func (r *Reconciler) processReconcileRequest(esc *operatorv1alpha1.ExternalSecre
 			}
 			oErr = fmt.Errorf("failed to fetch customresourcedefinitions.apiextensions.k8s.io %q during reconciliation: %w", req, err)
 		}
-		if err := r.updateAnnotations(crd); err != nil {
+		if err := r.updateAnnotations(esc, crd); err != nil {
 			oErr = fmt.Errorf("failed to update annotations in %q: %w", req, err)
 		}
 	}
@@ -240,22 +246,46 @@ func (r *Reconciler) processReconcileRequest(esc *operatorv1alpha1.ExternalSecre
 	return ctrl.Result{}, oErr
 }
 
-// updateAnnotations is for updating the annotations on the managed CRDs.
-func (r *Reconciler) updateAnnotations(crd *crdv1.CustomResourceDefinition) error {
+// getInjectCAAnnotations returns the annotations to be set on the managed CRDs, for the CA bundle to be
+// injected by the configured certificate provider. The annotation of the other provider is nil, for it to
+// be removed, as the CA bundle would otherwise be injected by both the providers.
+func getInjectCAAnnotations(esc *operatorv1alpha1.ExternalSecretsConfig) map[string]*string {
+	annotations := map[string]*string{
+		common.CertManagerInjectCAFromAnnotation: nil,
+		common.ServiceCAInjectCABundleAnnotation: nil,
+	}
+	switch {
+	case common.IsServiceCAConfigEnabled(esc):
+		annotations[common.ServiceCAInjectCABundleAnnotation] = ptr.To("true")
+	case common.IsInjectCertManagerAnnotationEnabled(esc):
+		annotations[common.CertManagerInjectCAFromAnnotation] = ptr.To(common.GetCertManagerInjectCAFromAnnotationValue(esc))
+	}
+	return annotations
+}
+
+// updateAnnotations is for updating the annotations on the managed CRDs, which adds the annotation of
+// the configured certificate provider, and removes the annotation of the other provider in the same patch.
+func (r *Reconciler) updateAnnotations(esc *operatorv1alpha1.ExternalSecretsConfig, crd *crdv1.CustomResourceDefinition) error {
 	annotations := crd.GetAnnotations()
-	if val, ok := annotations[common.CertManagerInjectCAFromAnnotation]; !ok || val != common.CertManagerInjectCAFromAnnotationValue {
-		patch := client.RawPatch(types.MergePatchType,
-			[]byte(fmt.Sprintf("{\"metadata\":{\"annotations\":{\"%s\":\"%s\"}}}",
-				common.CertManagerInjectCAFromAnnotation, common.CertManagerInjectCAFromAnnotationValue)),
-		)
-		if err := r.Patch(r.ctx, crd, patch); err != nil {
-			return err
+	changed := make(map[string]*string)
+	for key, value := range getInjectCAAnnotations(esc) {
+		current, exists := annotations[key]
+		if (value == nil && exists) || (value != nil && (!exists || current != *value)) {
+			changed[key] = value
 		}
 	}
-	return nil
+	if len(changed) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(map[string]any{"metadata": map[string]any{"annotations": changed}})
+	if err != nil {
+		return fmt.Errorf("failed to create annotations patch: %w", err)
+	}
+	return r.Patch(r.ctx, crd, client.RawPatch(types.MergePatchType, data))
 }
 
-func (r *Reconciler) updateAnnotationsInAllCRDs() error {
+func (r *Reconciler) updateAnnotationsInAllCRDs(esc *operatorv1alpha1.ExternalSecretsConfig) error {
 	managedCRDList := &crdv1.CustomResourceDefinitionList{}
 	crdLabelFilter := map[string]string{
 		requestEnqueueLabelKey: requestEnqueueLabelValue,
@@ -269,7 +299,7 @@ func (r *Reconciler) updateAnnotationsInAllCRDs() error {
 	}
 
 	for _, crd := range managedCRDList.Items {
-		if err := r.updateAnnotations(&crd); err != nil {
+		if err := r.updateAnnotations(esc, &crd); err != nil {
 			return fmt.Errorf("failed to update annotations in %q: %w", crd.GetName(), err)
 		}
 	}
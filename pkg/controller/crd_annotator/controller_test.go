@@ -93,6 +93,39 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reconciliation successful for a specific CRD with service-ca enabled",
+			request: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Name: commontest.TestCRDName,
+				},
+			},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.GetCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) error {
+					switch o := obj.(type) {
+					case *operatorv1alpha1.ExternalSecretsConfig:
+						esc := commontest.TestExternalSecretsConfig()
+						esc.Spec.ControllerConfig.CertProvider = &operatorv1alpha1.CertProvidersConfig{
+							ServiceCA: &operatorv1alpha1.ServiceCAConfig{
+								Mode: operatorv1alpha1.Enabled,
+							},
+						}
+						esc.DeepCopyInto(o)
+					case *crdv1.CustomResourceDefinition:
+						crd := testCRD()
+						crd.DeepCopyInto(o)
+					}
+					return nil
+				})
+			},
+			expectedStatusCondition: []metav1.Condition{
+				{
+					Type:   operatorv1alpha1.UpdateAnnotation,
+					Status: metav1.ConditionTrue,
+					Reason: operatorv1alpha1.ReasonCompleted,
+				},
+			},
+		},
 		{
 			name: "reconciliation successful for all CRDs",
 			request: ctrl.Request{
@@ -207,6 +240,25 @@ func TestReconcile(t *testing.T) {
 			},
 			expectedStatusCondition: []metav1.Condition{},
 		},
+		{
+			name: "reconciliation fails while removing annotations with config disabled",
+			request: ctrl.Request{
+				NamespacedName: types.NamespacedName{
+					Name: commontest.TestCRDName,
+				},
+			},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.GetCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) error {
+					if o, ok := obj.(*operatorv1alpha1.ExternalSecretsConfig); ok {
+						commontest.TestExternalSecretsConfig().DeepCopyInto(o)
+					}
+					return nil
+				})
+				m.ListReturns(commontest.TestClientError)
+			},
+			expectedStatusCondition: []metav1.Condition{},
+			wantErr:                 `failed while removing annotations in all CRDs: failed to list managed CRD resources: test client error`,
+		},
 		{
 			name: "reconciliation fails while listing CRD",
 			request: ctrl.Request{
@@ -434,3 +486,104 @@ func TestReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateAnnotations(t *testing.T) {
+	tests := []struct {
+		name      string
+		esc       func(*operatorv1alpha1.ExternalSecretsConfig)
+		crd       func(*crdv1.CustomResourceDefinition)
+		wantPatch string
+	}{
+		{
+			name:      "cert-manager inject-ca-from annotation added",
+			esc:       testExtendExternalSecretsConfig,
+			wantPatch: `{"metadata":{"annotations":{"cert-manager.io/inject-ca-from":"external-secrets/external-secrets-webhook"}}}`,
+		},
+		{
+			name: "service-ca inject-cabundle annotation added",
+			esc: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = &operatorv1alpha1.CertProvidersConfig{
+					ServiceCA: &operatorv1alpha1.ServiceCAConfig{
+						Mode: operatorv1alpha1.Enabled,
+					},
+				}
+			},
+			wantPatch: `{"metadata":{"annotations":{"service.beta.openshift.io/inject-cabundle":"true"}}}`,
+		},
+		{
+			name: "service-ca inject-cabundle annotation exists",
+			esc: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = &operatorv1alpha1.CertProvidersConfig{
+					ServiceCA: &operatorv1alpha1.ServiceCAConfig{
+						Mode: operatorv1alpha1.Enabled,
+					},
+				}
+			},
+			crd: func(crd *crdv1.CustomResourceDefinition) {
+				crd.Annotations[common.ServiceCAInjectCABundleAnnotation] = "true"
+			},
+		},
+		{
+			name: "cert-manager annotation removed on switching to service-ca",
+			esc: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = &operatorv1alpha1.CertProvidersConfig{
+					ServiceCA: &operatorv1alpha1.ServiceCAConfig{
+						Mode: operatorv1alpha1.Enabled,
+					},
+				}
+			},
+			crd: func(crd *crdv1.CustomResourceDefinition) {
+				crd.Annotations[common.CertManagerInjectCAFromAnnotation] = "external-secrets/external-secrets-webhook"
+			},
+			wantPatch: `{"metadata":{"annotations":{"cert-manager.io/inject-ca-from":null,"service.beta.openshift.io/inject-cabundle":"true"}}}`,
+		},
+		{
+			name: "service-ca annotation removed on switching to cert-manager",
+			esc:  testExtendExternalSecretsConfig,
+			crd: func(crd *crdv1.CustomResourceDefinition) {
+				crd.Annotations[common.ServiceCAInjectCABundleAnnotation] = "true"
+			},
+			wantPatch: `{"metadata":{"annotations":{"cert-manager.io/inject-ca-from":"external-secrets/external-secrets-webhook","service.beta.openshift.io/inject-cabundle":null}}}`,
+		},
+		{
+			name: "annotations removed when no provider injects the CA bundle",
+			esc:  func(esc *operatorv1alpha1.ExternalSecretsConfig) {},
+			crd: func(crd *crdv1.CustomResourceDefinition) {
+				crd.Annotations[common.CertManagerInjectCAFromAnnotation] = "external-secrets/external-secrets-webhook"
+				crd.Annotations[common.ServiceCAInjectCABundleAnnotation] = "true"
+			},
+			wantPatch: `{"metadata":{"annotations":{"cert-manager.io/inject-ca-from":null,"service.beta.openshift.io/inject-cabundle":null}}}`,
+		},
+		{
+			name: "no annotations to remove when no provider injects the CA bundle",
+			esc:  func(esc *operatorv1alpha1.ExternalSecretsConfig) {},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			esc := commontest.TestExternalSecretsConfig()
+			tt.esc(esc)
+			crd := testCRD()
+			if tt.crd != nil {
+				tt.crd(crd)
+			}
+
+			if err := r.updateAnnotations(esc, crd); err != nil {
+				t.Fatalf("updateAnnotations() unexpected error: %v", err)
+			}
+			var gotPatch string
+			if mock.PatchCallCount() != 0 {
+				_, _, patch, _ := mock.PatchArgsForCall(0)
+				data, _ := patch.Data(crd)
+				gotPatch = string(data)
+			}
+			if gotPatch != tt.wantPatch {
+				t.Errorf("updateAnnotations() patch: %s, want: %s", gotPatch, tt.wantPatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package external_secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	operatorclient "github.com/openshift/external-secrets-operator/pkg/controller/client"
+)
+
+// +kubebuilder:webhook:path=/validate-operator-openshift-io-v1alpha1-externalsecretsconfig,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.openshift.io,resources=externalsecretsconfigs,verbs=create;update,versions=v1alpha1,name=vexternalsecretsconfig.operator.openshift.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-operator-openshift-io-v1alpha1-externalsecretsmanager,mutating=false,failurePolicy=fail,sideEffects=None,groups=operator.openshift.io,resources=externalsecretsmanagers,verbs=create;update,versions=v1alpha1,name=vexternalsecretsmanager.operator.openshift.io,admissionReviewVersions=v1
+
+// externalSecretsConfigValidator validates the ExternalSecretsConfig at admission time, with the same
+// validations made by the controller before deploying the operand, for the invalid configurations to be
+// rejected right away instead of being reported in the status conditions.
+type externalSecretsConfigValidator struct {
+	// client is an uncached client, for reading the resources referenced in the ExternalSecretsConfig.
+	client operatorclient.CtrlClient
+}
+
+// externalSecretsManagerValidator validates the global configuration in ExternalSecretsManager at admission time.
+type externalSecretsManagerValidator struct{}
+
+var (
+	_ admission.CustomValidator = &externalSecretsConfigValidator{}
+	_ admission.CustomValidator = &externalSecretsManagerValidator{}
+)
+
+// SetupWebhookWithManager registers the validating admission webhooks for the ExternalSecretsConfig
+// and ExternalSecretsManager resources with the manager's webhook server.
+func SetupWebhookWithManager(mgr ctrl.Manager) error {
+	uc, err := NewUncachedClient(mgr)
+	if err != nil {
+		return err
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&operatorv1alpha1.ExternalSecretsConfig{}).
+		WithValidator(&externalSecretsConfigValidator{client: uc}).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to register externalsecretsconfigs.operator.openshift.io webhook: %w", err)
+	}
+
+	if err := ctrl.NewWebhookManagedBy(mgr).
+		For(&operatorv1alpha1.ExternalSecretsManager{}).
+		WithValidator(&externalSecretsManagerValidator{}).
+		Complete(); err != nil {
+		return fmt.Errorf("failed to register externalsecretsmanagers.operator.openshift.io webhook: %w", err)
+	}
+	return nil
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *externalSecretsConfigValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	esc, ok := obj.(*operatorv1alpha1.ExternalSecretsConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected an ExternalSecretsConfig object, got %T", obj)
+	}
+	return nil, v.validate(ctx, esc)
+}
+
+// ValidateUpdate implements admission.CustomValidator. The updates not modifying the spec, like the
+// finalizer being added or removed by the controller, are always allowed.
+func (v *externalSecretsConfigValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldESC, ok := oldObj.(*operatorv1alpha1.ExternalSecretsConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected an ExternalSecretsConfig object, got %T", oldObj)
+	}
+	esc, ok := newObj.(*operatorv1alpha1.ExternalSecretsConfig)
+	if !ok {
+		return nil, fmt.Errorf("expected an ExternalSecretsConfig object, got %T", newObj)
+	}
+	if !esc.DeletionTimestamp.IsZero() || equality.Semantic.DeepEqual(oldESC.Spec, esc.Spec) {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, esc)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *externalSecretsConfigValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate returns an Invalid error with all the field errors found in the ExternalSecretsConfig.
+func (v *externalSecretsConfigValidator) validate(ctx context.Context, esc *operatorv1alpha1.ExternalSecretsConfig) error {
+	errs := validateExternalSecretsConfigSpec(esc)
+	errs = append(errs, v.validateReferences(ctx, esc)...)
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(operatorv1alpha1.GroupVersion.WithKind("ExternalSecretsConfig").GroupKind(), esc.GetName(), errs)
+}
+
+// validateReferences validates that cert-manager is installed when enabled, and that the resources
+// referenced in the ExternalSecretsConfig exist.
+func (v *externalSecretsConfigValidator) validateReferences(ctx context.Context, esc *operatorv1alpha1.ExternalSecretsConfig) field.ErrorList {
+	var errs field.ErrorList
+
+	if isCertManagerConfigEnabled(esc) {
+		fldPath := field.NewPath("spec", "controllerConfig", "certProvider", "certManager")
+		installed, err := isCRDEstablished(ctx, v.client, certificateCRDObjectName)
+		switch {
+		case err != nil:
+			errs = append(errs, field.InternalError(fldPath.Child("mode"), err))
+		case !installed:
+			errs = append(errs, field.Invalid(fldPath.Child("mode"), esc.Spec.ControllerConfig.CertProvider.CertManager.Mode, "cert-manager is not installed"))
+		default:
+			errs = append(errs, v.validateIssuerRef(ctx, esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef, getNamespace(esc), fldPath.Child("issuerRef"))...)
+		}
+	}
+
+	if isBitwardenConfigEnabled(esc) && isBitwardenSecretRefConfigured(esc) {
+		fldPath := field.NewPath("spec", "plugins", "bitwardenSecretManagerProvider", "secretRef", "name")
+		errs = append(errs, v.validateSecretRef(ctx, esc.Spec.Plugins.BitwardenSecretManagerProvider.SecretRef, getNamespace(esc), fldPath)...)
+	}
+
+	if secretRef := getWebhookCertificateSecretRef(esc); secretRef != nil {
+		fldPath := field.NewPath("spec", "appConfig", "webhookConfig", "certificateSecretRef", "name")
+		errs = append(errs, v.validateSecretRef(ctx, secretRef, getNamespace(esc), fldPath)...)
+	}
+
+	return errs
+}
+
+// validateSecretRef validates that the referenced secret exists in the operand namespace.
+func (v *externalSecretsConfigValidator) validateSecretRef(ctx context.Context, secretRef *operatorv1alpha1.SecretReference, namespace string, fldPath *field.Path) field.ErrorList {
+	key := types.NamespacedName{Name: secretRef.Name, Namespace: namespace}
+	exist, err := v.client.Exists(ctx, key, &corev1.Secret{})
+	switch {
+	case err != nil:
+		return field.ErrorList{field.InternalError(fldPath, fmt.Errorf("failed to fetch %q secret: %w", key, err))}
+	case !exist:
+		return field.ErrorList{field.NotFound(fldPath, secretRef.Name)}
+	}
+	return nil
+}
+
+// validateIssuerRef validates that the referenced cert-manager Issuer or ClusterIssuer exists. The Issuer
+// is looked up in the operand namespace, where the certificates are created.
+func (v *externalSecretsConfigValidator) validateIssuerRef(ctx context.Context, issuerRef *operatorv1alpha1.ObjectReference, namespace string, fldPath *field.Path) field.ErrorList {
+	if issuerRef == nil || issuerRef.Name == "" {
+		return nil
+	}
+
+	var (
+		object client.Object
+		key    = types.NamespacedName{Name: issuerRef.Name}
+	)
+	switch {
+	case issuerRef.Kind == "" || strings.EqualFold(issuerRef.Kind, issuerKind):
+		object = &certmanagerv1.Issuer{}
+		key.Namespace = namespace
+	case strings.EqualFold(issuerRef.Kind, clusterIssuerKind):
+		object = &certmanagerv1.ClusterIssuer{}
+	default:
+		return field.ErrorList{field.NotSupported(fldPath.Child("kind"), issuerRef.Kind, []string{issuerKind, clusterIssuerKind})}
+	}
+
+	exist, err := v.client.Exists(ctx, key, object)
+	if err != nil {
+		return field.ErrorList{field.InternalError(fldPath.Child("name"), fmt.Errorf("failed to fetch %q issuer: %w", key, err))}
+	}
+	if !exist {
+		return field.ErrorList{field.NotFound(fldPath.Child("name"), issuerRef.Name)}
+	}
+	return nil
+}
+
+// validateExternalSecretsConfigSpec validates the ExternalSecretsConfig spec fields, which are validated by
+// the controller when deploying the operand and cannot be expressed as CEL validations in the CRD.
+func validateExternalSecretsConfigSpec(esc *operatorv1alpha1.ExternalSecretsConfig) field.ErrorList {
+	appConfigPath := field.NewPath("spec", "appConfig")
+	errs := validateCommonConfigs(&esc.Spec.ApplicationConfig.CommonConfigs, appConfigPath)
+
+	for name, component := range esc.Spec.ApplicationConfig.Components {
+		fldPath := appConfigPath.Child("components").Key(string(name))
+		if component.Resources != nil {
+			errs = append(errs, validateResourceRequirements(*component.Resources, fldPath)...)
+		}
+		if component.Affinity != nil {
+			errs = append(errs, validateAffinityRules(component.Affinity, fldPath)...)
+		}
+		errs = append(errs, validateTolerationsConfig(component.Tolerations, fldPath)...)
+		errs = append(errs, validateNodeSelectorConfig(component.NodeSelector, fldPath)...)
+		errs = append(errs, validateTopologySpreadConstraints(component.TopologySpreadConstraints, fldPath)...)
+		if component.PriorityClassName != "" {
+			errs = append(errs, validatePriorityClassName(component.PriorityClassName, fldPath)...)
+		}
+	}
+
+	if esc.Spec.ControllerConfig.Namespace != nil {
+		errs = append(errs, validateNamespaceConfig(esc.Spec.ControllerConfig.Namespace, field.NewPath("spec", "controllerConfig", "namespace"))...)
+	}
+	if isCertManagerConfigEnabled(esc) {
+		errs = append(errs, validateCertManagerConfig(esc.Spec.ControllerConfig.CertProvider.CertManager, field.NewPath("spec", "controllerConfig", "certProvider", "certManager"))...)
+	}
+	errs = append(errs, validateConfigOverrides(esc.Spec.UnsupportedConfigOverrides, field.NewPath("spec", "unsupportedConfigOverrides"))...)
+	return errs
+}
+
+// ValidateCreate implements admission.CustomValidator.
+func (v *externalSecretsManagerValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	esm, ok := obj.(*operatorv1alpha1.ExternalSecretsManager)
+	if !ok {
+		return nil, fmt.Errorf("expected an ExternalSecretsManager object, got %T", obj)
+	}
+	return nil, validateExternalSecretsManager(esm)
+}
+
+// ValidateUpdate implements admission.CustomValidator.
+func (v *externalSecretsManagerValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldESM, ok := oldObj.(*operatorv1alpha1.ExternalSecretsManager)
+	if !ok {
+		return nil, fmt.Errorf("expected an ExternalSecretsManager object, got %T", oldObj)
+	}
+	esm, ok := newObj.(*operatorv1alpha1.ExternalSecretsManager)
+	if !ok {
+		return nil, fmt.Errorf("expected an ExternalSecretsManager object, got %T", newObj)
+	}
+	if !esm.DeletionTimestamp.IsZero() || equality.Semantic.DeepEqual(oldESM.Spec, esm.Spec) {
+		return nil, nil
+	}
+	return nil, validateExternalSecretsManager(esm)
+}
+
+// ValidateDelete implements admission.CustomValidator.
+func (v *externalSecretsManagerValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateExternalSecretsManager returns an Invalid error with all the field errors found in the global configuration.
+func validateExternalSecretsManager(esm *operatorv1alpha1.ExternalSecretsManager) error {
+	if esm.Spec.GlobalConfig == nil {
+		return nil
+	}
+	fldPath := field.NewPath("spec", "globalConfig")
+	errs := metav1validation.ValidateLabels(esm.Spec.GlobalConfig.Labels, fldPath.Child("labels"))
+	errs = append(errs, validateCommonConfigs(&esm.Spec.GlobalConfig.CommonConfigs, fldPath)...)
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(operatorv1alpha1.GroupVersion.WithKind("ExternalSecretsManager").GroupKind(), esm.GetName(), errs)
+}
+
+// validateCommonConfigs validates the configurations common to ExternalSecretsConfig and ExternalSecretsManager.
+func validateCommonConfigs(config *operatorv1alpha1.CommonConfigs, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if config.Resources != nil {
+		errs = append(errs, validateResourceRequirements(*config.Resources, fldPath)...)
+	}
+	if config.Affinity != nil {
+		errs = append(errs, validateAffinityRules(config.Affinity, fldPath)...)
+	}
+	errs = append(errs, validateTolerationsConfig(config.Tolerations, fldPath)...)
+	errs = append(errs, validateNodeSelectorConfig(config.NodeSelector, fldPath)...)
+	if config.Proxy != nil {
+		errs = append(errs, validateProxyConfig(config.Proxy, fldPath.Child("proxy"))...)
+	}
+	return errs
+}
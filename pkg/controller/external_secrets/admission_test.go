@@ -0,0 +1,269 @@
+package external_secrets
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	crdv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+)
+
+func TestExternalSecretsConfigValidator(t *testing.T) {
+	tests := []struct {
+		name                        string
+		preReq                      func(*fakes.FakeCtrlClient)
+		updateExternalSecretsConfig func(*operatorv1alpha1.ExternalSecretsConfig)
+		wantErr                     string
+	}{
+		{
+			name: "valid configuration",
+		},
+		{
+			name: "resource requests exceeding limits",
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ApplicationConfig.Resources = &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+				}
+			},
+			wantErr: `ExternalSecretsConfig.operator.openshift.io "cluster" is invalid: spec.appConfig.resources.requests: Invalid value: "256Mi": must be less than or equal to memory limit of 128Mi`,
+		},
+		{
+			name: "invalid component configurations",
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ApplicationConfig.Components = map[operatorv1alpha1.ComponentName]operatorv1alpha1.ComponentConfig{
+					operatorv1alpha1.Webhook: {
+						PriorityClassName: "High_Priority",
+						Tolerations:       []corev1.Toleration{{Key: "node-role", Operator: corev1.TolerationOpExists, Value: "infra"}},
+					},
+				}
+			},
+			wantErr: `ExternalSecretsConfig.operator.openshift.io "cluster" is invalid: [spec.appConfig.components[Webhook].tolerations[0].operator: Invalid value: "infra": value must be empty when ` + "`operator`" + ` is 'Exists', spec.appConfig.components[Webhook].priorityClassName: Invalid value: "High_Priority": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')]`,
+		},
+		{
+			name: "invalid namespace label and proxy",
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.Namespace = &operatorv1alpha1.NamespaceConfig{
+					Labels: map[string]string{"openshift.io/cluster-monitoring": "true?"},
+				}
+				esc.Spec.ApplicationConfig.Proxy = &operatorv1alpha1.ProxyConfig{HTTPProxy: "proxy.example.com:3128"}
+			},
+			wantErr: `ExternalSecretsConfig.operator.openshift.io "cluster" is invalid: [spec.appConfig.proxy.httpProxy: Invalid value: "proxy.example.com:3128": URL scheme must be either http or https, spec.controllerConfig.namespace.labels: Invalid value: "true?": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')]`,
+		},
+		{
+			name: "invalid unsupported config overrides",
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.UnsupportedConfigOverrides = []operatorv1alpha1.ConfigOverride{
+					{Kind: "Deployment", Name: "external-secrets", Patch: "spec:\n  paused: true"},
+					{Kind: "Deployment", Name: "external-secrets", Type: operatorv1alpha1.JSONPatch, Patch: `{"op": "remove", "path": "/spec/paused"}`},
+				}
+			},
+			wantErr: `ExternalSecretsConfig.operator.openshift.io "cluster" is invalid: spec.unsupportedConfigOverrides[1].patch: Invalid value: "{\"op\": \"remove\", \"path\": \"/spec/paused\"}": patch is not a list of JSON patch operations: json: cannot unmarshal object into Go value of type jsonpatch.Patch`,
+		},
+		{
+			name: "cert-manager enabled but not installed",
+			preReq: func(m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, nil)
+			},
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = &operatorv1alpha1.CertProvidersConfig{
+					CertManager: &operatorv1alpha1.CertManagerConfig{
+						Mode:      operatorv1alpha1.Enabled,
+						IssuerRef: &operatorv1alpha1.ObjectReference{Name: "test-issuer"},
+					},
+				}
+			},
+			wantErr: `ExternalSecretsConfig.operator.openshift.io "cluster" is invalid: spec.controllerConfig.certProvider.certManager.mode: Invalid value: "Enabled": cert-manager is not installed`,
+		},
+		{
+			name: "referenced issuer does not exist",
+			preReq: func(m *fakes.FakeCtrlClient) {
+				m.ExistsCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+					if crd, ok := obj.(*crdv1.CustomResourceDefinition); ok {
+						testCertificateCRD(crdv1.ConditionTrue).DeepCopyInto(crd)
+						return true, nil
+					}
+					return false, nil
+				})
+			},
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = &operatorv1alpha1.CertProvidersConfig{
+					CertManager: &operatorv1alpha1.CertManagerConfig{
+						Mode:      operatorv1alpha1.Enabled,
+						IssuerRef: &operatorv1alpha1.ObjectReference{Name: "test-issuer", Kind: "clusterissuer"},
+					},
+				}
+			},
+			wantErr: `ExternalSecretsConfig.operator.openshift.io "cluster" is invalid: spec.controllerConfig.certProvider.certManager.issuerRef.name: Not found: "test-issuer"`,
+		},
+		{
+			name: "referenced issuer exists",
+			preReq: func(m *fakes.FakeCtrlClient) {
+				m.ExistsCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+					switch o := obj.(type) {
+					case *crdv1.CustomResourceDefinition:
+						testCertificateCRD(crdv1.ConditionTrue).DeepCopyInto(o)
+						return true, nil
+					case *certmanagerv1.Issuer:
+						return key.Namespace == "external-secrets", nil
+					}
+					return false, nil
+				})
+			},
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = &operatorv1alpha1.CertProvidersConfig{
+					CertManager: &operatorv1alpha1.CertManagerConfig{
+						Mode:      operatorv1alpha1.Enabled,
+						IssuerRef: &operatorv1alpha1.ObjectReference{Name: "test-issuer"},
+					},
+				}
+			},
+		},
+		{
+			name: "invalid certificate secret template",
+			preReq: func(m *fakes.FakeCtrlClient) {
+				m.ExistsCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+					switch o := obj.(type) {
+					case *crdv1.CustomResourceDefinition:
+						testCertificateCRD(crdv1.ConditionTrue).DeepCopyInto(o)
+						return true, nil
+					case *certmanagerv1.Issuer:
+						return true, nil
+					}
+					return false, nil
+				})
+			},
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = &operatorv1alpha1.CertProvidersConfig{
+					CertManager: &operatorv1alpha1.CertManagerConfig{
+						Mode:      operatorv1alpha1.Enabled,
+						IssuerRef: &operatorv1alpha1.ObjectReference{Name: "test-issuer"},
+						SecretTemplate: &operatorv1alpha1.CertificateSecretTemplate{
+							Labels: map[string]string{"-test": "label"},
+						},
+					},
+				}
+			},
+			wantErr: `ExternalSecretsConfig.operator.openshift.io "cluster" is invalid: spec.controllerConfig.certProvider.certManager.secretTemplate.labels: Invalid value: "-test": name part must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]')`,
+		},
+		{
+			name: "referenced bitwarden secret does not exist",
+			preReq: func(m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, nil)
+			},
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.Plugins.BitwardenSecretManagerProvider = &operatorv1alpha1.BitwardenSecretManagerProvider{
+					Mode:      operatorv1alpha1.Enabled,
+					SecretRef: &operatorv1alpha1.SecretReference{Name: "bitwarden-tls"},
+				}
+			},
+			wantErr: `ExternalSecretsConfig.operator.openshift.io "cluster" is invalid: spec.plugins.bitwardenSecretManagerProvider.secretRef.name: Not found: "bitwarden-tls"`,
+		},
+		{
+			name: "fetching bitwarden secret fails",
+			preReq: func(m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, commontest.TestClientError)
+			},
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.Plugins.BitwardenSecretManagerProvider = &operatorv1alpha1.BitwardenSecretManagerProvider{
+					Mode:      operatorv1alpha1.Enabled,
+					SecretRef: &operatorv1alpha1.SecretReference{Name: "bitwarden-tls"},
+				}
+			},
+			wantErr: `ExternalSecretsConfig.operator.openshift.io "cluster" is invalid: spec.plugins.bitwardenSecretManagerProvider.secretRef.name: Internal error: failed to fetch "external-secrets/bitwarden-tls" secret: test client error`,
+		},
+		{
+			name: "referenced webhook certificate secret does not exist",
+			preReq: func(m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, nil)
+			},
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ApplicationConfig.WebhookConfig = &operatorv1alpha1.WebhookConfig{
+					CertificateSecretRef: &operatorv1alpha1.SecretReference{Name: "webhook-tls"},
+				}
+			},
+			wantErr: `ExternalSecretsConfig.operator.openshift.io "cluster" is invalid: spec.appConfig.webhookConfig.certificateSecretRef.name: Not found: "webhook-tls"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &fakes.FakeCtrlClient{}
+			if tt.preReq != nil {
+				tt.preReq(mock)
+			}
+			v := &externalSecretsConfigValidator{client: mock}
+
+			esc := commontest.TestExternalSecretsConfig()
+			if tt.updateExternalSecretsConfig != nil {
+				tt.updateExternalSecretsConfig(esc)
+			}
+
+			_, err := v.ValidateCreate(context.Background(), esc)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("ValidateCreate() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+
+			_, err = v.ValidateUpdate(context.Background(), commontest.TestExternalSecretsConfig(), esc)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("ValidateUpdate() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+
+			// updates not modifying the spec are not validated.
+			if _, err = v.ValidateUpdate(context.Background(), esc, esc.DeepCopy()); err != nil {
+				t.Errorf("ValidateUpdate() with unchanged spec err: %v", err)
+			}
+		})
+	}
+}
+
+func TestExternalSecretsManagerValidator(t *testing.T) {
+	tests := []struct {
+		name         string
+		globalConfig *operatorv1alpha1.GlobalConfig
+		wantErr      string
+	}{
+		{
+			name: "global configuration not set",
+		},
+		{
+			name: "valid global configuration",
+			globalConfig: &operatorv1alpha1.GlobalConfig{
+				Labels: map[string]string{"team": "security"},
+				CommonConfigs: operatorv1alpha1.CommonConfigs{
+					NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+				},
+			},
+		},
+		{
+			name: "invalid label and node selector",
+			globalConfig: &operatorv1alpha1.GlobalConfig{
+				Labels: map[string]string{"team": "security?"},
+				CommonConfigs: operatorv1alpha1.CommonConfigs{
+					NodeSelector: map[string]string{"node-role.kubernetes.io/infra/": ""},
+				},
+			},
+			wantErr: `ExternalSecretsManager.operator.openshift.io "cluster" is invalid: [spec.globalConfig.labels: Invalid value: "security?": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?'), spec.globalConfig.nodeSelector: Invalid value: "node-role.kubernetes.io/infra/": a qualified name must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]') with an optional DNS subdomain prefix and '/' (e.g. 'example.com/MyName')]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			esm := commontest.TestExternalSecretsManager()
+			esm.Spec.GlobalConfig = tt.globalConfig
+
+			_, err := (&externalSecretsManagerValidator{}).ValidateCreate(context.Background(), esm)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("ValidateCreate() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+		})
+	}
+}
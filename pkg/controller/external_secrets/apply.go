@@ -0,0 +1,130 @@
+package external_secrets
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+)
+
+// applyResource reconciles the resource to the desired state with server-side apply, which updates all
+// the fields set in the desired state and leaves the fields managed by the other actors as is.
+// fetched must be an empty object of the same type as desired, and is populated with the existing resource.
+// The resource was modified when the apply changed the resourceVersion, which is reported as the drift correction.
+// The apply is skipped when the desired state is unchanged since last applied, and the resource was not modified.
+func (r *Reconciler) applyResource(esc *operatorv1alpha1.ExternalSecretsConfig, desired, fetched client.Object, kind string, recon bool) error {
+	resourceName := strings.TrimPrefix(client.ObjectKeyFromObject(desired).String(), "/")
+	r.log.V(4).Info("reconciling resource", "kind", kind, "name", resourceName)
+
+	if err := r.applyConfigOverrides(esc, desired); err != nil {
+		return err
+	}
+	if err := setDesiredStateHash(desired); err != nil {
+		return common.NewIrrecoverableError(err, "failed to compute desired state hash of %s %s resource", resourceName, kind)
+	}
+	r.inventory.add(desired)
+	exist, err := r.Exists(r.ctx, client.ObjectKeyFromObject(desired), fetched)
+	if err != nil {
+		return common.FromClientError(err, "failed to check %s %s resource already exists", resourceName, kind)
+	}
+	if exist && recon {
+		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "%s %s resource already exists, maybe from previous installation", resourceName, kind)
+	}
+	if exist && r.isDriftCorrectionDisabled(fetched, kind) {
+		return nil
+	}
+	if exist && r.isDesiredStateApplied(desired, fetched) {
+		r.log.V(4).Info("resource desired state is unchanged since last applied", "kind", kind, "name", resourceName)
+		return nil
+	}
+
+	if exist {
+		if err := r.UpgradeManagedFields(r.ctx, fetched); err != nil {
+			return common.FromClientError(err, "failed to migrate managed fields of %s %s resource", resourceName, kind)
+		}
+	}
+	if err := r.Apply(r.ctx, desired); err != nil {
+		return common.FromClientError(err, "failed to apply %s %s resource", resourceName, kind)
+	}
+	r.recordAppliedState(desired)
+	switch {
+	case !exist:
+		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "%s resource %s created", kind, resourceName)
+	case desired.GetResourceVersion() != fetched.GetResourceVersion():
+		r.log.V(1).Info("resource has been modified, updated to desired state", "kind", kind, "name", resourceName)
+		recordDriftCorrection(kind)
+		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "%s resource %s reconciled back to desired state", kind, resourceName)
+	default:
+		r.log.V(4).Info("resource already exists and is in expected state", "kind", kind, "name", resourceName)
+	}
+	return nil
+}
+
+// appliedResource is the state of a resource as last applied by the controller.
+type appliedResource struct {
+	hash            string
+	generation      int64
+	resourceVersion string
+}
+
+// setDesiredStateHash adds the annotation with the hash of the desired state of the resource.
+func setDesiredStateHash(desired client.Object) error {
+	annotations := desired.GetAnnotations()
+	delete(annotations, common.DesiredStateHashAnnotation)
+	desired.SetAnnotations(annotations)
+
+	data, err := json.Marshal(desired)
+	if err != nil {
+		return fmt.Errorf("failed to encode %T object: %w", desired, err)
+	}
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[common.DesiredStateHashAnnotation] = fmt.Sprintf("%x", sha256.Sum256(data))
+	desired.SetAnnotations(annotations)
+	return nil
+}
+
+// isDesiredStateApplied returns whether the desired state of the resource is the same as last applied, and
+// the resource was not modified since, for the apply to be skipped. The generation is compared for the resources
+// whose spec changes are tracked by the server, and the resourceVersion for the others, whose generation is not
+// set. The labels are compared as well, since the changes in the metadata do not increment the generation.
+func (r *Reconciler) isDesiredStateApplied(desired, fetched client.Object) bool {
+	applied, ok := r.appliedResources[inventoryKey(desired)]
+	hash := desired.GetAnnotations()[common.DesiredStateHashAnnotation]
+	if !ok || applied.hash != hash || fetched.GetAnnotations()[common.DesiredStateHashAnnotation] != hash {
+		return false
+	}
+	for k, v := range desired.GetLabels() {
+		if value, ok := fetched.GetLabels()[k]; !ok || value != v {
+			return false
+		}
+	}
+	if fetched.GetGeneration() != 0 {
+		return fetched.GetGeneration() == applied.generation
+	}
+	return fetched.GetResourceVersion() == applied.resourceVersion
+}
+
+// recordAppliedState records the state of the resource returned by the server on applying the desired state.
+func (r *Reconciler) recordAppliedState(applied client.Object) {
+	if r.appliedResources == nil {
+		r.appliedResources = make(map[string]appliedResource)
+	}
+	r.appliedResources[inventoryKey(applied)] = appliedResource{
+		hash:            applied.GetAnnotations()[common.DesiredStateHashAnnotation],
+		generation:      applied.GetGeneration(),
+		resourceVersion: applied.GetResourceVersion(),
+	}
+}
+
+// resourceDisplayName returns the kind and the name of the resource, as used in the events and the status messages.
+func resourceDisplayName(kind string, obj client.Object) string {
+	return fmt.Sprintf("%s %s", kind, strings.TrimPrefix(client.ObjectKeyFromObject(obj).String(), "/"))
+}
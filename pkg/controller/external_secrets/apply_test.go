@@ -0,0 +1,219 @@
+package external_secrets
+
+import (
+	"context"
+	"testing"
+
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+	"github.com/openshift/external-secrets-operator/pkg/operator/assets"
+)
+
+func TestApplyResourceDesiredStateUnchanged(t *testing.T) {
+	tests := []struct {
+		name        string
+		object      func() client.Object
+		modify      func(applied, fetched client.Object)
+		wantApplied bool
+	}{
+		{
+			name:   "resource unchanged since last applied",
+			object: func() client.Object { return testPodDisruptionBudget(webhookPodDisruptionBudgetAssetName) },
+		},
+		{
+			name:   "desired state changed",
+			object: func() client.Object { return testPodDisruptionBudget(webhookPodDisruptionBudgetAssetName) },
+			modify: func(applied, fetched client.Object) {
+				for _, obj := range []client.Object{applied, fetched} {
+					annotations := obj.GetAnnotations()
+					annotations[common.DesiredStateHashAnnotation] = "previous"
+					obj.SetAnnotations(annotations)
+				}
+			},
+			wantApplied: true,
+		},
+		{
+			name:   "resource spec modified by other actors",
+			object: func() client.Object { return testPodDisruptionBudget(webhookPodDisruptionBudgetAssetName) },
+			modify: func(applied, fetched client.Object) {
+				fetched.SetGeneration(2)
+			},
+			wantApplied: true,
+		},
+		{
+			name:   "resource status updated",
+			object: func() client.Object { return testPodDisruptionBudget(webhookPodDisruptionBudgetAssetName) },
+			modify: func(applied, fetched client.Object) {
+				fetched.SetResourceVersion("2")
+			},
+		},
+		{
+			name:   "resource label removed by other actors",
+			object: func() client.Object { return testPodDisruptionBudget(webhookPodDisruptionBudgetAssetName) },
+			modify: func(applied, fetched client.Object) {
+				labels := fetched.GetLabels()
+				delete(labels, requestEnqueueLabelKey)
+				fetched.SetLabels(labels)
+			},
+			wantApplied: true,
+		},
+		{
+			name: "resource without generation modified by other actors",
+			object: func() client.Object {
+				role := common.DecodeRoleObjBytes(assets.MustAsset(controllerRoleLeaderElectionAssetName))
+				common.UpdateResourceLabels(role, controllerDefaultResourceLabels)
+				return role
+			},
+			modify: func(applied, fetched client.Object) {
+				applied.SetGeneration(0)
+				fetched.SetGeneration(0)
+				fetched.SetResourceVersion("2")
+			},
+			wantApplied: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			r.inventory = make(resourceInventory)
+			esc := commontest.TestExternalSecretsConfig()
+
+			applied := tt.object()
+			if err := setDesiredStateHash(applied); err != nil {
+				t.Fatalf("setDesiredStateHash() unexpected error: %v", err)
+			}
+			applied.SetGeneration(1)
+			applied.SetResourceVersion("1")
+			fetched := applied.DeepCopyObject().(client.Object)
+			if tt.modify != nil {
+				tt.modify(applied, fetched)
+			}
+			r.recordAppliedState(applied)
+			mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+				switch o := obj.(type) {
+				case *policyv1.PodDisruptionBudget:
+					fetched.(*policyv1.PodDisruptionBudget).DeepCopyInto(o)
+				case *rbacv1.Role:
+					fetched.(*rbacv1.Role).DeepCopyInto(o)
+				}
+				return true, nil
+			})
+
+			var fetchedObj client.Object = &policyv1.PodDisruptionBudget{}
+			if _, ok := applied.(*rbacv1.Role); ok {
+				fetchedObj = &rbacv1.Role{}
+			}
+			if err := r.applyResource(esc, tt.object(), fetchedObj, "test", false); err != nil {
+				t.Fatalf("applyResource() unexpected error: %v", err)
+			}
+			if applied := mock.ApplyCallCount() != 0; applied != tt.wantApplied {
+				t.Errorf("applyResource() applied: %v, want: %v", applied, tt.wantApplied)
+			}
+			if migrated := mock.UpgradeManagedFieldsCallCount() != 0; migrated != tt.wantApplied {
+				t.Errorf("applyResource() managed fields migration checked: %v, want: %v", migrated, tt.wantApplied)
+			}
+		})
+	}
+}
+
+func TestApplyResourceUpgradeManagedFields(t *testing.T) {
+	tests := []struct {
+		name         string
+		exist        bool
+		upgradeErr   error
+		wantMigrated bool
+		wantErr      string
+	}{
+		{
+			name: "resource does not exist",
+		},
+		{
+			name:         "existing resource fields migrated before applying",
+			exist:        true,
+			wantMigrated: true,
+		},
+		{
+			name:         "existing resource fields migration fails",
+			exist:        true,
+			upgradeErr:   commontest.TestClientError,
+			wantMigrated: true,
+			wantErr:      "failed to migrate managed fields of external-secrets/external-secrets-webhook-pdb poddisruptionbudget resource: test client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			r.inventory = make(resourceInventory)
+			esc := commontest.TestExternalSecretsConfig()
+
+			mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+				if tt.exist {
+					testPodDisruptionBudget(webhookPodDisruptionBudgetAssetName).DeepCopyInto(obj.(*policyv1.PodDisruptionBudget))
+				}
+				return tt.exist, nil
+			})
+			mock.UpgradeManagedFieldsReturns(tt.upgradeErr)
+
+			fetched := &policyv1.PodDisruptionBudget{}
+			err := r.applyResource(esc, testPodDisruptionBudget(webhookPodDisruptionBudgetAssetName), fetched, "poddisruptionbudget", false)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("applyResource() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if migrated := mock.UpgradeManagedFieldsCallCount() != 0; migrated != tt.wantMigrated {
+				t.Fatalf("applyResource() managed fields migration checked: %v, want: %v", migrated, tt.wantMigrated)
+			}
+			if tt.wantMigrated {
+				// the resource fetched for the drift check is used for the migration, instead of being read again.
+				if _, obj := mock.UpgradeManagedFieldsArgsForCall(0); obj != fetched {
+					t.Errorf("applyResource() managed fields migration checked on %p, want fetched object %p", obj, fetched)
+				}
+			}
+		})
+	}
+}
+
+func TestResourceInventoryHash(t *testing.T) {
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ApplicationConfig.Replicas = &operatorv1alpha1.ComponentReplicas{Webhook: ptr.To(int32(2))}
+
+	getInventoryHash := func(esc *operatorv1alpha1.ExternalSecretsConfig) string {
+		r := testReconciler(t)
+		mock := &fakes.FakeCtrlClient{}
+		r.CtrlClient = mock
+		r.inventory = make(resourceInventory)
+		if err := r.createOrApplyPodDisruptionBudgets(esc, controllerDefaultResourceLabels, false); err != nil {
+			t.Fatalf("createOrApplyPodDisruptionBudgets() unexpected error: %v", err)
+		}
+		return r.inventory.hash()
+	}
+
+	hash := getInventoryHash(esc)
+	if got := getInventoryHash(esc); got != hash {
+		t.Errorf("resourceInventory.hash() changed for same desired state: %s, want: %s", got, hash)
+	}
+	esc.Spec.UnsupportedConfigOverrides = []operatorv1alpha1.ConfigOverride{
+		{Kind: "PodDisruptionBudget", Name: "external-secrets-webhook-pdb", Patch: `{"spec": {"unhealthyPodEvictionPolicy": "AlwaysAllow"}}`},
+	}
+	if got := getInventoryHash(esc); got == hash {
+		t.Errorf("resourceInventory.hash() not changed for modified desired state: %s", got)
+	}
+	esc.Spec.UnsupportedConfigOverrides = nil
+	esc.Spec.ApplicationConfig.Replicas.Controller = ptr.To(int32(2))
+	if got := getInventoryHash(esc); got == hash {
+		t.Errorf("resourceInventory.hash() not changed for additional resource: %s", got)
+	}
+}
@@ -0,0 +1,244 @@
+package external_secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// optionalResourcesCache serves the objects of the optional CRDs, like the cert-manager Certificate, which
+// could be installed or removed while the operator is running. The manager cache must be able to resolve
+// every object configured with selectors when it is created, hence each optional kind is served from a
+// dedicated cache created on first use, and which is stopped when the informer of the kind is removed.
+// Every other object is served from the wrapped manager cache.
+type optionalResourcesCache struct {
+	cache.Cache
+
+	config   *rest.Config
+	opts     cache.Options
+	optional map[schema.GroupVersionKind]optionalResource
+
+	mu      sync.Mutex
+	ctx     context.Context
+	started map[schema.GroupVersionKind]*optionalResourceCache
+	// namespaces is the namespace the dedicated cache of an optional kind is restricted to, for the
+	// namespaced kinds which are not created by the controller, like the cert-manager Issuer.
+	namespaces map[schema.GroupVersionKind]string
+}
+
+// optionalResource is the object of an optional kind, and the cache config to use for it.
+type optionalResource struct {
+	obj      client.Object
+	byObject cache.ByObject
+}
+
+// optionalResourceCache is the dedicated cache of an optional kind.
+type optionalResourceCache struct {
+	cache.Cache
+	cancel context.CancelFunc
+}
+
+func newOptionalResourcesCache(c cache.Cache, config *rest.Config, opts cache.Options, optional map[client.Object]cache.ByObject) (*optionalResourcesCache, error) {
+	oc := &optionalResourcesCache{
+		Cache:      c,
+		config:     config,
+		opts:       opts,
+		optional:   make(map[schema.GroupVersionKind]optionalResource, len(optional)),
+		started:    make(map[schema.GroupVersionKind]*optionalResourceCache),
+		namespaces: make(map[schema.GroupVersionKind]string),
+	}
+	for obj, byObject := range optional {
+		gvk, err := apiutil.GVKForObject(obj, opts.Scheme)
+		if err != nil {
+			return nil, err
+		}
+		oc.optional[gvk] = optionalResource{obj: obj, byObject: byObject}
+	}
+	return oc, nil
+}
+
+// cacheFor returns the cache to be used for the given object or object list.
+func (c *optionalResourcesCache) cacheFor(obj runtime.Object) (cache.Cache, error) {
+	gvk, err := apiutil.GVKForObject(obj, c.opts.Scheme)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := obj.(client.ObjectList); ok {
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	}
+	return c.cacheForKind(gvk)
+}
+
+func (c *optionalResourcesCache) cacheForKind(gvk schema.GroupVersionKind) (cache.Cache, error) {
+	o, ok := c.optional[gvk]
+	if !ok {
+		return c.Cache, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if oc, ok := c.started[gvk]; ok {
+		return oc, nil
+	}
+
+	opts := c.opts
+	opts.ByObject = map[client.Object]cache.ByObject{o.obj: c.byObjectFor(gvk)}
+	nc, err := cache.New(c.config, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cache for %s: %w", gvk, err)
+	}
+	oc := &optionalResourceCache{Cache: nc}
+	if c.ctx != nil {
+		oc.start(c.ctx)
+	}
+	c.started[gvk] = oc
+	return oc, nil
+}
+
+// byObjectFor returns the cache config of the optional kind, restricted to the namespace when set.
+// Must be called with the lock held.
+func (c *optionalResourcesCache) byObjectFor(gvk schema.GroupVersionKind) cache.ByObject {
+	byObject := c.optional[gvk].byObject
+	if namespace, ok := c.namespaces[gvk]; ok {
+		byObject.Namespaces = map[string]cache.Config{namespace: {}}
+	}
+	return byObject
+}
+
+// setNamespace restricts the dedicated cache of the optional kind to the passed namespace. The cache
+// already created for another namespace is stopped, and is created again for the namespace on next use.
+func (c *optionalResourcesCache) setNamespace(obj client.Object, namespace string) error {
+	gvk, err := apiutil.GVKForObject(obj, c.opts.Scheme)
+	if err != nil {
+		return err
+	}
+	if _, ok := c.optional[gvk]; !ok {
+		return fmt.Errorf("%s is not an optional resource", gvk)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if current, ok := c.namespaces[gvk]; ok && current == namespace {
+		return nil
+	}
+	c.namespaces[gvk] = namespace
+	if oc, ok := c.started[gvk]; ok {
+		if oc.cancel != nil {
+			oc.cancel()
+		}
+		delete(c.started, gvk)
+	}
+	return nil
+}
+
+func (c *optionalResourcesCache) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	oc, err := c.cacheFor(obj)
+	if err != nil {
+		return err
+	}
+	return oc.Get(ctx, key, obj, opts...)
+}
+
+func (c *optionalResourcesCache) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	oc, err := c.cacheFor(list)
+	if err != nil {
+		return err
+	}
+	return oc.List(ctx, list, opts...)
+}
+
+func (c *optionalResourcesCache) GetInformer(ctx context.Context, obj client.Object, opts ...cache.InformerGetOption) (cache.Informer, error) {
+	oc, err := c.cacheFor(obj)
+	if err != nil {
+		return nil, err
+	}
+	return oc.GetInformer(ctx, obj, opts...)
+}
+
+func (c *optionalResourcesCache) GetInformerForKind(ctx context.Context, gvk schema.GroupVersionKind, opts ...cache.InformerGetOption) (cache.Informer, error) {
+	oc, err := c.cacheForKind(gvk)
+	if err != nil {
+		return nil, err
+	}
+	return oc.GetInformerForKind(ctx, gvk, opts...)
+}
+
+func (c *optionalResourcesCache) IndexField(ctx context.Context, obj client.Object, field string, extractValue client.IndexerFunc) error {
+	oc, err := c.cacheFor(obj)
+	if err != nil {
+		return err
+	}
+	return oc.IndexField(ctx, obj, field, extractValue)
+}
+
+// RemoveInformer stops the dedicated cache when the object is of an optional kind, which is
+// created again when the object is requested next.
+func (c *optionalResourcesCache) RemoveInformer(ctx context.Context, obj client.Object) error {
+	gvk, err := apiutil.GVKForObject(obj, c.opts.Scheme)
+	if err != nil {
+		return err
+	}
+	if _, ok := c.optional[gvk]; !ok {
+		return c.Cache.RemoveInformer(ctx, obj)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if oc, ok := c.started[gvk]; ok {
+		if oc.cancel != nil {
+			oc.cancel()
+		}
+		delete(c.started, gvk)
+	}
+	return nil
+}
+
+// Start starts the dedicated caches created before the manager is started, along
+// with the manager cache, and blocks till the context is done.
+func (c *optionalResourcesCache) Start(ctx context.Context) error {
+	c.mu.Lock()
+	c.ctx = ctx
+	for _, oc := range c.started {
+		oc.start(ctx)
+	}
+	c.mu.Unlock()
+
+	return c.Cache.Start(ctx)
+}
+
+func (c *optionalResourcesCache) WaitForCacheSync(ctx context.Context) bool {
+	c.mu.Lock()
+	caches := make([]cache.Cache, 0, len(c.started))
+	for _, oc := range c.started {
+		caches = append(caches, oc)
+	}
+	c.mu.Unlock()
+
+	for _, oc := range caches {
+		if !oc.WaitForCacheSync(ctx) {
+			return false
+		}
+	}
+	return c.Cache.WaitForCacheSync(ctx)
+}
+
+func (oc *optionalResourceCache) start(ctx context.Context) {
+	ctx, oc.cancel = context.WithCancel(ctx)
+	go func() {
+		if err := oc.Cache.Start(ctx); err != nil {
+			ctrl.Log.WithName("cache-setup").Error(err, "failed to start cache of optional resource")
+		}
+	}()
+}
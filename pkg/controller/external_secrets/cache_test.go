@@ -0,0 +1,80 @@
+package external_secrets
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestOptionalResourcesCacheSetNamespace(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := certmanagerv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add cert-manager types to scheme: %v", err)
+	}
+	oc, err := newOptionalResourcesCache(nil, &rest.Config{}, cache.Options{Scheme: scheme}, buildOptionalCacheObjectList())
+	if err != nil {
+		t.Fatalf("newOptionalResourcesCache() unexpected error: %v", err)
+	}
+	issuerGVK, _ := apiutil.GVKForObject(&certmanagerv1.Issuer{}, scheme)
+
+	if got := oc.byObjectFor(issuerGVK).Namespaces; got != nil {
+		t.Errorf("byObjectFor() namespaces before restricting: %v, want: nil", got)
+	}
+
+	tests := []struct {
+		name       string
+		namespace  string
+		wantCancel bool
+	}{
+		{
+			name:      "cache restricted to operand namespace",
+			namespace: "external-secrets",
+			// the cache created before the namespace is known is stopped.
+			wantCancel: true,
+		},
+		{
+			name:      "cache restricted to same namespace again",
+			namespace: "external-secrets",
+		},
+		{
+			name:       "cache restricted to another namespace",
+			namespace:  "custom-namespace",
+			wantCancel: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cancelled := false
+			oc.started[issuerGVK] = &optionalResourceCache{cancel: func() { cancelled = true }}
+
+			if err := oc.setNamespace(&certmanagerv1.Issuer{}, tt.namespace); err != nil {
+				t.Fatalf("setNamespace() unexpected error: %v", err)
+			}
+			if cancelled != tt.wantCancel {
+				t.Errorf("setNamespace() cache stopped: %v, want: %v", cancelled, tt.wantCancel)
+			}
+			if _, started := oc.started[issuerGVK]; started == tt.wantCancel {
+				t.Errorf("setNamespace() cache retained: %v, want: %v", started, !tt.wantCancel)
+			}
+			want := map[string]cache.Config{tt.namespace: {}}
+			if got := oc.byObjectFor(issuerGVK).Namespaces; !reflect.DeepEqual(got, want) {
+				t.Errorf("byObjectFor() namespaces: %v, want: %v", got, want)
+			}
+		})
+	}
+
+	clusterIssuerGVK, _ := apiutil.GVKForObject(&certmanagerv1.ClusterIssuer{}, scheme)
+	if got := oc.byObjectFor(clusterIssuerGVK).Namespaces; got != nil {
+		t.Errorf("byObjectFor() ClusterIssuer namespaces: %v, want: nil", got)
+	}
+	if err := oc.setNamespace(&certmanagerv1.CertificateRequest{}, "external-secrets"); err == nil {
+		t.Errorf("setNamespace() expected error for kind which is not optional")
+	}
+}
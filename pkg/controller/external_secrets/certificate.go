@@ -5,7 +5,11 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -32,9 +36,13 @@ func (r *Reconciler) createOrApplyCertificates(esc *operatorv1alpha1.ExternalSec
 		if bitwardenConfig.SecretRef != nil && bitwardenConfig.SecretRef.Name != "" {
 			return r.assertSecretRefExists(esc, esc.Spec.Plugins.BitwardenSecretManagerProvider)
 		}
+		if common.IsServiceCAConfigEnabled(esc) {
+			// certificate is generated by service-ca for the annotated bitwarden-sdk-server service.
+			return nil
+		}
 		if !isCertManagerConfigEnabled(esc) {
 			return common.NewIrrecoverableError(fmt.Errorf("invalid bitwardenSecretManagerProvider config"),
-				"either secretRef or certManagerConfig must be configured, when bitwardenSecretManagerProvider is enabled")
+				"either secretRef, certManagerConfig or serviceCAConfig must be configured, when bitwardenSecretManagerProvider is enabled").WithCause(common.InvalidConfiguration)
 		}
 		if err := r.createOrApplyCertificate(esc, resourceLabels, bitwardenCertificateAssetName, recon); err != nil {
 			return err
@@ -43,40 +51,132 @@ func (r *Reconciler) createOrApplyCertificates(esc *operatorv1alpha1.ExternalSec
 	return nil
 }
 
-func (r *Reconciler) createOrApplyCertificate(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string, fileName string, recon bool) error {
-	desired, err := r.getCertificateObject(esc, resourceLabels, fileName)
-	if err != nil {
-		return err
+// getCertificateAssetNames returns the asset names of the cert-manager Certificates required for the configuration.
+func getCertificateAssetNames(esc *operatorv1alpha1.ExternalSecretsConfig) []string {
+	if !isCertManagerConfigEnabled(esc) {
+		return nil
 	}
+	assetNames := []string{webhookCertificateAssetName}
+	if isBitwardenConfigEnabled(esc) {
+		bitwardenConfig := esc.Spec.Plugins.BitwardenSecretManagerProvider
+		if bitwardenConfig.SecretRef == nil || bitwardenConfig.SecretRef.Name == "" {
+			assetNames = append(assetNames, bitwardenCertificateAssetName)
+		}
+	}
+	return assetNames
+}
 
-	certificateName := fmt.Sprintf("%s/%s", desired.GetNamespace(), desired.GetName())
-	r.log.V(4).Info("reconciling certificate resource", "name", certificateName)
-	fetched := &certmanagerv1.Certificate{}
-	exist, err := r.Exists(r.ctx, client.ObjectKeyFromObject(desired), fetched)
-	if err != nil {
-		return common.FromClientError(err, "failed to check %s certificate resource already exists", certificateName)
+// getCertificatesReadyCondition returns the condition indicating whether the cert-manager Certificates
+// created for the operand are ready, and is nil when cert-manager is not configured.
+func (r *Reconciler) getCertificatesReadyCondition(esc *operatorv1alpha1.ExternalSecretsConfig) (*metav1.Condition, error) {
+	assetNames := getCertificateAssetNames(esc)
+	if len(assetNames) == 0 {
+		return nil, nil
 	}
 
-	if exist && recon {
-		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "%s certificate resource already exists, maybe from previous installation", certificateName)
+	cond := &metav1.Condition{
+		Type:               operatorv1alpha1.CertificatesReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             operatorv1alpha1.ReasonReady,
+		Message:            "certificates are ready",
+		ObservedGeneration: esc.GetGeneration(),
 	}
-	if exist && common.HasObjectChanged(desired, fetched) {
-		r.log.V(1).Info("certificate has been modified, updating to desired state", "name", certificateName)
-		if err := r.UpdateWithRetry(r.ctx, desired); err != nil {
-			return common.FromClientError(err, "failed to update %s certificate resource", certificateName)
+	notReady := make([]string, 0)
+
+	// the certificates cannot be issued while the issuer is not ready, like when
+	// the ACME account registration fails, hence the issuer state is reported too.
+	if config := esc.Spec.ControllerConfig.CertProvider.CertManager; config.IssuerRef != nil && config.IssuerRef.Name != "" {
+		issuerRef := getCertificateIssuerRef(config)
+		issuer, err := r.getIssuer(issuerRef, getNamespace(esc))
+		if err != nil {
+			return nil, common.FromClientError(err, "failed to fetch issuer")
+		}
+		if issuer == nil {
+			notReady = append(notReady, fmt.Sprintf("%s %q does not exist", issuerRef.Kind, issuerRef.Name))
+		} else if msg, failed := issuerNotReadyMessage(issuer); msg != "" {
+			notReady = append(notReady, fmt.Sprintf("%s %q is not ready: %s", issuerRef.Kind, issuerRef.Name, msg))
+			if failed {
+				cond.Reason = operatorv1alpha1.ReasonFailed
+			}
 		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "certificate resource %s reconciled back to desired state", certificateName)
-	} else {
-		r.log.V(4).Info("certificate resource already exists and is in expected state", "name", certificateName)
 	}
-	if !exist {
-		if err := r.Create(r.ctx, desired); err != nil {
-			return common.FromClientError(err, "failed to create %s certificate resource", certificateName)
+
+	for _, assetName := range assetNames {
+		certificate := common.DecodeCertificateObjBytes(assets.MustAsset(assetName))
+		updateNamespace(certificate, esc)
+		certificateName := fmt.Sprintf("%s/%s", certificate.GetNamespace(), certificate.GetName())
+
+		fetched := &certmanagerv1.Certificate{}
+		exist, err := r.Exists(r.ctx, client.ObjectKeyFromObject(certificate), fetched)
+		if err != nil {
+			return nil, common.FromClientError(err, "failed to fetch %s certificate resource", certificateName)
+		}
+		if !exist {
+			notReady = append(notReady, fmt.Sprintf("certificate %s does not exist", certificateName))
+			continue
+		}
+
+		if msg := certificateNotReadyMessage(fetched); msg != "" {
+			notReady = append(notReady, fmt.Sprintf("certificate %s is not ready: %s", certificateName, msg))
+			if fetched.Status.LastFailureTime != nil {
+				cond.Reason = operatorv1alpha1.ReasonFailed
+			}
 		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "certificate resource %s created", certificateName)
 	}
 
-	return nil
+	if len(notReady) != 0 {
+		cond.Status = metav1.ConditionFalse
+		if cond.Reason != operatorv1alpha1.ReasonFailed {
+			cond.Reason = operatorv1alpha1.ReasonInProgress
+		}
+		cond.Message = strings.Join(notReady, "; ")
+	}
+	return cond, nil
+}
+
+// issuerNotReadyMessage returns the details of the issuer not being ready, and whether the issuer has
+// failed, and is empty when ready.
+func issuerNotReadyMessage(issuer certmanagerv1.GenericIssuer) (string, bool) {
+	for _, c := range issuer.GetStatus().Conditions {
+		if c.Type != certmanagerv1.IssuerConditionReady {
+			continue
+		}
+		if c.Status == v1.ConditionTrue {
+			return "", false
+		}
+		return fmt.Sprintf("%s: %s", c.Reason, c.Message), c.Status == v1.ConditionFalse
+	}
+	return "waiting for the issuer to be ready", false
+}
+
+// certificateNotReadyMessage returns the details of the Certificate not being ready, and is empty when ready.
+// The failure reported on the Issuing condition is preferred, when the issuance has failed.
+func certificateNotReadyMessage(certificate *certmanagerv1.Certificate) string {
+	if certificate.Status.LastFailureTime != nil {
+		for _, c := range certificate.Status.Conditions {
+			if c.Type == certmanagerv1.CertificateConditionIssuing && c.Status == v1.ConditionFalse {
+				return fmt.Sprintf("%s: %s", c.Reason, c.Message)
+			}
+		}
+	}
+	for _, c := range certificate.Status.Conditions {
+		if c.Type != certmanagerv1.CertificateConditionReady {
+			continue
+		}
+		if c.Status == v1.ConditionTrue {
+			return ""
+		}
+		return fmt.Sprintf("%s: %s", c.Reason, c.Message)
+	}
+	return "waiting for the certificate to be issued"
+}
+
+func (r *Reconciler) createOrApplyCertificate(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string, fileName string, recon bool) error {
+	desired, err := r.getCertificateObject(esc, resourceLabels, fileName)
+	if err != nil {
+		return err
+	}
+	return r.applyResource(esc, desired, &certmanagerv1.Certificate{}, "certificate", recon)
 }
 
 func (r *Reconciler) getCertificateObject(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string, fileName string) (*certmanagerv1.Certificate, error) {
@@ -110,21 +210,7 @@ func (r *Reconciler) updateCertificateParams(esc *operatorv1alpha1.ExternalSecre
 	}
 	externalSecretsNamespace := getNamespace(esc)
 
-	certificate.Spec.IssuerRef = v1.ObjectReference{
-		Name:  certManageConfig.IssuerRef.Name,
-		Kind:  certManageConfig.IssuerRef.Kind,
-		Group: certManageConfig.IssuerRef.Group,
-	}
-
-	// Since Kind and Group configs are optional. certmanagerv1.IssuerKind will
-	// be used as default for Kind and certmanagerapi.GroupName as default for
-	// Group.
-	if certificate.Spec.IssuerRef.Kind == "" {
-		certificate.Spec.IssuerRef.Kind = issuerKind
-	}
-	if certificate.Spec.IssuerRef.Group == "" {
-		certificate.Spec.IssuerRef.Group = issuerGroup
-	}
+	certificate.Spec.IssuerRef = getCertificateIssuerRef(certManageConfig)
 
 	if err := r.assertIssuerRefExists(certificate.Spec.IssuerRef, externalSecretsNamespace); err != nil {
 		return err
@@ -140,14 +226,111 @@ func (r *Reconciler) updateCertificateParams(esc *operatorv1alpha1.ExternalSecre
 		certificate.Spec.Duration = certManageConfig.CertificateDuration
 	}
 
+	if err := validateCertManagerConfig(certManageConfig, field.NewPath("spec", "controllerConfig", "certProvider", "certManager")).ToAggregate(); err != nil {
+		return err
+	}
+	updateCertificatePrivateKey(certificate, certManageConfig.PrivateKey)
+	updateCertificateSubject(certificate, certManageConfig.Subject)
+	if len(certManageConfig.Usages) != 0 {
+		certificate.Spec.Usages = make([]certmanagerv1.KeyUsage, 0, len(certManageConfig.Usages))
+		for _, usage := range certManageConfig.Usages {
+			certificate.Spec.Usages = append(certificate.Spec.Usages, certmanagerv1.KeyUsage(usage))
+		}
+	}
+	r.updateCertificateSecretTemplate(certificate, certManageConfig.SecretTemplate)
+
 	return nil
 }
 
+// updateCertificatePrivateKey sets the private key parameters configured, over the ones in the Certificate
+// template, like the PKCS8 encoding required for the bitwarden-sdk-server.
+func updateCertificatePrivateKey(certificate *certmanagerv1.Certificate, config *operatorv1alpha1.CertificatePrivateKey) {
+	if config == nil {
+		return
+	}
+	if certificate.Spec.PrivateKey == nil {
+		certificate.Spec.PrivateKey = &certmanagerv1.CertificatePrivateKey{}
+	}
+	privateKey := certificate.Spec.PrivateKey
+	if config.Algorithm != "" {
+		// the size in the template is not valid for a different algorithm.
+		if privateKey.Algorithm != certmanagerv1.PrivateKeyAlgorithm(config.Algorithm) {
+			privateKey.Size = 0
+		}
+		privateKey.Algorithm = certmanagerv1.PrivateKeyAlgorithm(config.Algorithm)
+	}
+	if config.Size != 0 {
+		privateKey.Size = int(config.Size)
+	}
+	if config.Encoding != "" {
+		privateKey.Encoding = certmanagerv1.PrivateKeyEncoding(config.Encoding)
+	}
+	if config.RotationPolicy != "" {
+		privateKey.RotationPolicy = certmanagerv1.PrivateKeyRotationPolicy(config.RotationPolicy)
+	}
+}
+
+func updateCertificateSubject(certificate *certmanagerv1.Certificate, config *operatorv1alpha1.CertificateSubject) {
+	if config == nil {
+		return
+	}
+	certificate.Spec.Subject = &certmanagerv1.X509Subject{
+		Organizations:       config.Organizations,
+		OrganizationalUnits: config.OrganizationalUnits,
+		Countries:           config.Countries,
+		Provinces:           config.Provinces,
+		Localities:          config.Localities,
+		StreetAddresses:     config.StreetAddresses,
+		PostalCodes:         config.PostalCodes,
+		SerialNumber:        config.SerialNumber,
+	}
+}
+
+// updateCertificateSecretTemplate sets the labels and annotations to be added by cert-manager to the certificate
+// secret. The labels reserved for the operand resources are skipped, since the secret could otherwise be taken
+// for a resource created by the operator.
+func (r *Reconciler) updateCertificateSecretTemplate(certificate *certmanagerv1.Certificate, config *operatorv1alpha1.CertificateSecretTemplate) {
+	if config == nil || (len(config.Labels) == 0 && len(config.Annotations) == 0) {
+		return
+	}
+	template := &certmanagerv1.CertificateSecretTemplate{}
+	for k, v := range config.Labels {
+		if disallowedLabelMatcher.MatchString(k) {
+			r.log.V(1).Info("skip adding unallowed certificate secret label configured in externalsecretsconfig.operator.openshift.io", "label", k, "value", v)
+			continue
+		}
+		if template.Labels == nil {
+			template.Labels = make(map[string]string, len(config.Labels))
+		}
+		template.Labels[k] = v
+	}
+	if len(config.Annotations) != 0 {
+		template.Annotations = make(map[string]string, len(config.Annotations))
+		for k, v := range config.Annotations {
+			template.Annotations[k] = v
+		}
+	}
+	certificate.Spec.SecretTemplate = template
+}
+
+// validateCertManagerConfig validates the labels and annotations configured for the certificate secrets.
+func validateCertManagerConfig(config *operatorv1alpha1.CertManagerConfig, fldPath *field.Path) field.ErrorList {
+	if config.SecretTemplate == nil {
+		return nil
+	}
+	fldPath = fldPath.Child("secretTemplate")
+	errs := metav1validation.ValidateLabels(config.SecretTemplate.Labels, fldPath.Child("labels"))
+	return append(errs, apivalidation.ValidateAnnotations(config.SecretTemplate.Annotations, fldPath.Child("annotations"))...)
+}
+
 func (r *Reconciler) assertIssuerRefExists(issueRef v1.ObjectReference, namespace string) error {
-	ifExists, err := r.getIssuer(issueRef, namespace)
-	if err != nil || !ifExists {
+	issuer, err := r.getIssuer(issueRef, namespace)
+	if err != nil {
 		return common.FromClientError(err, "failed to fetch issuer")
 	}
+	if issuer == nil {
+		return common.NewRetryRequiredError(fmt.Errorf("%s %q does not exist", issueRef.Kind, issueRef.Name), "failed to fetch issuer").WithCause(common.IssuerNotFound)
+	}
 	return nil
 }
 
@@ -165,25 +348,48 @@ func (r *Reconciler) assertSecretRefExists(esc *operatorv1alpha1.ExternalSecrets
 	return nil
 }
 
-func (r *Reconciler) getIssuer(issuerRef v1.ObjectReference, namespace string) (bool, error) {
+// getCertificateIssuerRef returns the issuer configured for the Certificates. Since Kind and Group configs
+// are optional, certmanagerv1.IssuerKind will be used as default for Kind and certmanagerapi.GroupName as
+// default for Group.
+func getCertificateIssuerRef(config *operatorv1alpha1.CertManagerConfig) v1.ObjectReference {
+	issuerRef := v1.ObjectReference{
+		Name:  config.IssuerRef.Name,
+		Kind:  config.IssuerRef.Kind,
+		Group: config.IssuerRef.Group,
+	}
+	if issuerRef.Kind == "" {
+		issuerRef.Kind = issuerKind
+	}
+	if issuerRef.Group == "" {
+		issuerRef.Group = issuerGroup
+	}
+	return issuerRef
+}
+
+// getIssuer returns the referenced Issuer or ClusterIssuer, and is nil when it does not exist. The
+// issuers are watched, hence are read from the cache.
+func (r *Reconciler) getIssuer(issuerRef v1.ObjectReference, namespace string) (certmanagerv1.GenericIssuer, error) {
 	namespacedName := types.NamespacedName{
 		Name:      issuerRef.Name,
 		Namespace: namespace,
 	}
 
-	var object client.Object
-	switch issuerRef.Kind {
-	case clusterIssuerKind:
+	var object certmanagerv1.GenericIssuer
+	if strings.EqualFold(issuerRef.Kind, clusterIssuerKind) {
 		object = &certmanagerv1.ClusterIssuer{}
-	case issuerKind:
+		namespacedName.Namespace = ""
+	} else {
 		object = &certmanagerv1.Issuer{}
 	}
 
-	ifExists, err := r.UncachedClient.Exists(r.ctx, namespacedName, object)
+	ifExists, err := r.Exists(r.ctx, namespacedName, object)
 	if err != nil {
-		return ifExists, fmt.Errorf("failed to fetch %q issuer: %w", namespacedName, err)
+		return nil, fmt.Errorf("failed to fetch %q issuer: %w", namespacedName, err)
+	}
+	if !ifExists {
+		return nil, nil
 	}
-	return ifExists, nil
+	return object, nil
 }
 
 func updateNamespaceForFQDN(fqdns []string, namespace string) []string {
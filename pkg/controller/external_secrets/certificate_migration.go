@@ -0,0 +1,300 @@
+package external_secrets
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/operator/assets"
+)
+
+// getDesiredWebhookCertificateProvider returns the provider of the webhook certificate configured in the
+// ExternalSecretsConfig, with the cert-manager issuer kind and group defaulted and in the canonical case.
+func getDesiredWebhookCertificateProvider(esc *operatorv1alpha1.ExternalSecretsConfig) operatorv1alpha1.CertificateProviderReference {
+	switch {
+	case isCertManagerConfigEnabled(esc):
+		provider := operatorv1alpha1.CertificateProviderReference{
+			Provider:   operatorv1alpha1.CertManagerProvider,
+			SecretName: certmanagerTLSSecretWebhook,
+		}
+		if issuerRef := esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef; issuerRef != nil {
+			provider.IssuerRef = &operatorv1alpha1.ObjectReference{
+				Name:  issuerRef.Name,
+				Kind:  issuerKind,
+				Group: issuerGroup,
+			}
+			// kind is allowed in any case in the ExternalSecretsConfig.
+			if strings.EqualFold(issuerRef.Kind, certmanagerv1.ClusterIssuerKind) {
+				provider.IssuerRef.Kind = certmanagerv1.ClusterIssuerKind
+			}
+		}
+		return provider
+	case common.IsServiceCAConfigEnabled(esc):
+		return operatorv1alpha1.CertificateProviderReference{
+			Provider:   operatorv1alpha1.ServiceCAProvider,
+			SecretName: serviceCATLSSecretWebhook,
+		}
+	case getWebhookCertificateSecretRef(esc) != nil:
+		return operatorv1alpha1.CertificateProviderReference{
+			Provider:   operatorv1alpha1.UserProvidedProvider,
+			SecretName: getWebhookCertificateSecretRef(esc).Name,
+		}
+	}
+	return operatorv1alpha1.CertificateProviderReference{
+		Provider:   operatorv1alpha1.CertControllerProvider,
+		SecretName: certControllerTLSSecretWebhook,
+	}
+}
+
+// getWebhookCertificateProvider returns the provider of the certificate to be used by the webhook, which
+// is the previous provider while the certificate of the new provider is being issued during a migration.
+func getWebhookCertificateProvider(esc *operatorv1alpha1.ExternalSecretsConfig) operatorv1alpha1.CertificateProviderReference {
+	if isCertificateMigrationProvisioning(esc) {
+		return esc.Status.CertificateMigration.Source
+	}
+	return getDesiredWebhookCertificateProvider(esc)
+}
+
+// isCertificateMigrationInProgress returns whether the webhook certificate is being migrated to a different provider.
+func isCertificateMigrationInProgress(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	migration := esc.Status.CertificateMigration
+	return migration != nil && migration.Phase != operatorv1alpha1.CertificateMigrationCompleted
+}
+
+// isCertificateMigrationProvisioning returns whether the certificate of the new provider is being issued, during
+// which the resources of the previous provider are retained, for the webhook to continue using its certificate.
+func isCertificateMigrationProvisioning(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	migration := esc.Status.CertificateMigration
+	return migration != nil && migration.Phase == operatorv1alpha1.CertificateMigrationProvisioning
+}
+
+func isSameCertificateProvider(a, b operatorv1alpha1.CertificateProviderReference) bool {
+	return a.Provider == b.Provider && a.SecretName == b.SecretName && isSameIssuer(a.IssuerRef, b.IssuerRef)
+}
+
+// isSameIssuer compares the kind and group case-insensitively, as the ExternalSecretsConfig allows them in any case.
+func isSameIssuer(a, b *operatorv1alpha1.ObjectReference) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Name == b.Name && strings.EqualFold(a.Kind, b.Kind) && strings.EqualFold(a.Group, b.Group)
+}
+
+func describeCertificateProvider(provider operatorv1alpha1.CertificateProviderReference) string {
+	switch provider.Provider {
+	case operatorv1alpha1.CertManagerProvider:
+		if provider.IssuerRef != nil {
+			return fmt.Sprintf("%s (%s/%s)", provider.Provider, provider.IssuerRef.Kind, provider.IssuerRef.Name)
+		}
+	case operatorv1alpha1.UserProvidedProvider:
+		return fmt.Sprintf("%s (secret %s)", provider.Provider, provider.SecretName)
+	}
+	return string(provider.Provider)
+}
+
+// reconcileCertificateMigration starts the migration of the webhook certificate when the provider configured differs
+// from the one of the certificate in use by the webhook, and moves an ongoing migration to the next phase. Must be
+// called before the operand resources are reconciled, which are configured for the current phase.
+//
+// The migration is started only for an existing webhook deployment, and the webhook continues to use the current
+// certificate until the certificate of the new provider is issued. The webhook is then switched to the new certificate
+// along with the caBundle injection, and the resources of the previous provider are pruned in the same reconciliation,
+// since the cert-controller would otherwise revert the caBundle injected by the new provider.
+func (r *Reconciler) reconcileCertificateMigration(esc *operatorv1alpha1.ExternalSecretsConfig) error {
+	target := getDesiredWebhookCertificateProvider(esc)
+	migration := esc.Status.CertificateMigration
+
+	if !isCertificateMigrationInProgress(esc) {
+		deployment, err := r.getWebhookDeployment(esc)
+		if err != nil || deployment == nil {
+			return err
+		}
+		active, err := r.getActiveWebhookCertificateProvider(esc, deployment, target)
+		if err != nil || active == nil || isSameCertificateProvider(*active, target) {
+			return err
+		}
+		r.setCertificateMigrationPhase(esc, operatorv1alpha1.CertificateMigrationProvisioning, *active, target)
+		return nil
+	}
+
+	// the provider was changed again before the ongoing migration completed.
+	if !isSameCertificateProvider(migration.Target, target) {
+		source := migration.Source
+		if migration.Phase == operatorv1alpha1.CertificateMigrationSwitching {
+			source = migration.Target
+		}
+		if isSameCertificateProvider(source, target) {
+			r.log.V(1).Info("webhook certificate migration cancelled", "target", describeCertificateProvider(migration.Target))
+			r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "CertificateMigration", "webhook certificate migration to %s cancelled", describeCertificateProvider(migration.Target))
+			esc.Status.CertificateMigration = nil
+			return nil
+		}
+		r.setCertificateMigrationPhase(esc, operatorv1alpha1.CertificateMigrationProvisioning, source, target)
+		return nil
+	}
+
+	switch migration.Phase {
+	case operatorv1alpha1.CertificateMigrationProvisioning:
+		issued, err := r.isWebhookCertificateIssued(esc, target)
+		if err != nil || !issued {
+			return err
+		}
+		r.setCertificateMigrationPhase(esc, operatorv1alpha1.CertificateMigrationSwitching, migration.Source, target)
+	case operatorv1alpha1.CertificateMigrationSwitching:
+		deployment, err := r.getWebhookDeployment(esc)
+		if err != nil || deployment == nil {
+			return err
+		}
+		active, err := r.getActiveWebhookCertificateProvider(esc, deployment, target)
+		if err != nil || active == nil || !isSameCertificateProvider(*active, target) {
+			return err
+		}
+		if msg, _ := deploymentRolloutStatus(deployment); msg != "" {
+			return nil
+		}
+		r.setCertificateMigrationPhase(esc, operatorv1alpha1.CertificateMigrationCompleted, migration.Source, target)
+	}
+	return nil
+}
+
+func (r *Reconciler) setCertificateMigrationPhase(esc *operatorv1alpha1.ExternalSecretsConfig, phase operatorv1alpha1.CertificateMigrationPhase,
+	source, target operatorv1alpha1.CertificateProviderReference) {
+	var message string
+	switch phase {
+	case operatorv1alpha1.CertificateMigrationProvisioning:
+		message = "waiting for the new certificate to be issued"
+	case operatorv1alpha1.CertificateMigrationSwitching:
+		message = "waiting for the rollout of the webhook using the new certificate"
+	case operatorv1alpha1.CertificateMigrationCompleted:
+		message = "webhook is using the new certificate"
+	}
+	esc.Status.CertificateMigration = &operatorv1alpha1.CertificateMigrationStatus{
+		Phase:              phase,
+		Source:             source,
+		Target:             target,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	}
+
+	r.log.V(1).Info("webhook certificate migration phase changed", "phase", phase,
+		"source", describeCertificateProvider(source), "target", describeCertificateProvider(target))
+	r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "CertificateMigration", "webhook certificate migration from %s to %s: %s",
+		describeCertificateProvider(source), describeCertificateProvider(target), message)
+}
+
+// getWebhookDeployment returns the webhook deployment, and is nil when it does not exist.
+func (r *Reconciler) getWebhookDeployment(esc *operatorv1alpha1.ExternalSecretsConfig) (*appsv1.Deployment, error) {
+	deployment := common.DecodeDeploymentObjBytes(assets.MustAsset(webhookDeploymentAssetName))
+	updateNamespace(deployment, esc)
+	key := client.ObjectKeyFromObject(deployment)
+
+	fetched := &appsv1.Deployment{}
+	exist, err := r.Exists(r.ctx, key, fetched)
+	if err != nil {
+		return nil, common.FromClientError(err, "failed to fetch %s deployment resource", key)
+	}
+	if !exist {
+		return nil, nil
+	}
+	return fetched, nil
+}
+
+// getActiveWebhookCertificateProvider returns the provider of the certificate in use by the webhook deployment, which
+// is identified by the secret mounted. The issuer of the cert-manager certificate is read from the secret annotations,
+// and the issuer of the target provider is assumed when the certificate is not issued yet.
+func (r *Reconciler) getActiveWebhookCertificateProvider(esc *operatorv1alpha1.ExternalSecretsConfig, deployment *appsv1.Deployment,
+	target operatorv1alpha1.CertificateProviderReference) (*operatorv1alpha1.CertificateProviderReference, error) {
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name != "certs" {
+			continue
+		}
+		if volume.Projected != nil {
+			for _, source := range volume.Projected.Sources {
+				if source.Secret != nil {
+					return &operatorv1alpha1.CertificateProviderReference{
+						Provider:   operatorv1alpha1.ServiceCAProvider,
+						SecretName: source.Secret.Name,
+					}, nil
+				}
+			}
+		}
+		if volume.Secret == nil {
+			return nil, nil
+		}
+
+		active := &operatorv1alpha1.CertificateProviderReference{SecretName: volume.Secret.SecretName}
+		switch active.SecretName {
+		case certControllerTLSSecretWebhook:
+			active.Provider = operatorv1alpha1.CertControllerProvider
+		case certmanagerTLSSecretWebhook:
+			active.Provider = operatorv1alpha1.CertManagerProvider
+			secret, err := r.getWebhookCertificateSecret(esc, active.SecretName)
+			if err != nil {
+				return nil, err
+			}
+			active.IssuerRef = getCertificateIssuer(secret)
+			if active.IssuerRef == nil {
+				active.IssuerRef = target.IssuerRef
+			}
+		default:
+			active.Provider = operatorv1alpha1.UserProvidedProvider
+		}
+		return active, nil
+	}
+	return nil, nil
+}
+
+// isWebhookCertificateIssued returns whether the certificate of the provider is populated in the secret, and for
+// cert-manager, whether it was issued by the configured issuer, as the certificate is reissued in the same secret.
+func (r *Reconciler) isWebhookCertificateIssued(esc *operatorv1alpha1.ExternalSecretsConfig, provider operatorv1alpha1.CertificateProviderReference) (bool, error) {
+	secret, err := r.getWebhookCertificateSecret(esc, provider.SecretName)
+	if err != nil || secret == nil {
+		return false, err
+	}
+	if len(secret.Data[corev1.TLSCertKey]) == 0 || len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		return false, nil
+	}
+	if provider.Provider == operatorv1alpha1.CertManagerProvider {
+		issuerRef := getCertificateIssuer(secret)
+		return issuerRef != nil && isSameIssuer(issuerRef, provider.IssuerRef), nil
+	}
+	return true, nil
+}
+
+// getWebhookCertificateSecret returns the webhook certificate secret, and is nil when it does not exist. Secrets
+// are cached only with the metadata, hence the uncached client is used.
+func (r *Reconciler) getWebhookCertificateSecret(esc *operatorv1alpha1.ExternalSecretsConfig, name string) (*corev1.Secret, error) {
+	key := client.ObjectKey{Namespace: getNamespace(esc), Name: name}
+	secret := &corev1.Secret{}
+	exist, err := r.UncachedClient.Exists(r.ctx, key, secret)
+	if err != nil {
+		return nil, common.FromClientError(err, "failed to fetch %s webhook certificate secret", key)
+	}
+	if !exist {
+		return nil, nil
+	}
+	return secret, nil
+}
+
+// getCertificateIssuer returns the issuer of the certificate in the secret, as annotated by cert-manager on
+// issuing it, and is nil when the secret was not populated by cert-manager.
+func getCertificateIssuer(secret *corev1.Secret) *operatorv1alpha1.ObjectReference {
+	if secret == nil || secret.GetAnnotations()[certmanagerv1.IssuerNameAnnotationKey] == "" {
+		return nil
+	}
+	annotations := secret.GetAnnotations()
+	return &operatorv1alpha1.ObjectReference{
+		Name:  annotations[certmanagerv1.IssuerNameAnnotationKey],
+		Kind:  cmp.Or(annotations[certmanagerv1.IssuerKindAnnotationKey], issuerKind),
+		Group: cmp.Or(annotations[certmanagerv1.IssuerGroupAnnotationKey], issuerGroup),
+	}
+}
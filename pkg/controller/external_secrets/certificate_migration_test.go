@@ -0,0 +1,306 @@
+package external_secrets
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+
+	"github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+	"github.com/openshift/external-secrets-operator/pkg/operator/assets"
+)
+
+var (
+	testCertControllerProvider = v1alpha1.CertificateProviderReference{
+		Provider:   v1alpha1.CertControllerProvider,
+		SecretName: certControllerTLSSecretWebhook,
+	}
+	testServiceCAProvider = v1alpha1.CertificateProviderReference{
+		Provider:   v1alpha1.ServiceCAProvider,
+		SecretName: serviceCATLSSecretWebhook,
+	}
+)
+
+func testCertManagerProvider(issuer string) v1alpha1.CertificateProviderReference {
+	return v1alpha1.CertificateProviderReference{
+		Provider:   v1alpha1.CertManagerProvider,
+		IssuerRef:  &v1alpha1.ObjectReference{Name: issuer, Kind: "ClusterIssuer", Group: "cert-manager.io"},
+		SecretName: certmanagerTLSSecretWebhook,
+	}
+}
+
+// testWebhookDeployment returns the webhook deployment mounting the certificate of the provider, with the rollout
+// complete or in progress.
+func testWebhookDeployment(provider v1alpha1.CertificateProviderReference, rolledOut bool) *appsv1.Deployment {
+	deployment := common.DecodeDeploymentObjBytes(assets.MustAsset(webhookDeploymentAssetName))
+	deployment.SetNamespace(commontest.TestExternalSecretsNamespace)
+	updateWebhookVolumeConfig(deployment, provider)
+	deployment.Spec.Replicas = ptr.To(int32(1))
+	deployment.Status = appsv1.DeploymentStatus{Replicas: 1, UpdatedReplicas: 1, AvailableReplicas: 1}
+	if !rolledOut {
+		deployment.Status.UpdatedReplicas = 0
+	}
+	return deployment
+}
+
+// testIssuedSecret returns the webhook certificate secret populated by the provider.
+func testIssuedSecret(provider v1alpha1.CertificateProviderReference) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      provider.SecretName,
+			Namespace: commontest.TestExternalSecretsNamespace,
+		},
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       []byte("cert"),
+			corev1.TLSPrivateKeyKey: []byte("key"),
+		},
+	}
+	if provider.IssuerRef != nil {
+		secret.Annotations = map[string]string{
+			certmanagerv1.IssuerNameAnnotationKey:  provider.IssuerRef.Name,
+			certmanagerv1.IssuerKindAnnotationKey:  provider.IssuerRef.Kind,
+			certmanagerv1.IssuerGroupAnnotationKey: provider.IssuerRef.Group,
+		}
+	}
+	return secret
+}
+
+func TestReconcileCertificateMigration(t *testing.T) {
+	certManager := func(issuer string) func(*v1alpha1.ExternalSecretsConfig) {
+		return func(esc *v1alpha1.ExternalSecretsConfig) {
+			esc.Spec.ControllerConfig.CertProvider = &v1alpha1.CertProvidersConfig{
+				CertManager: &v1alpha1.CertManagerConfig{
+					Mode:      v1alpha1.Enabled,
+					IssuerRef: &v1alpha1.ObjectReference{Name: issuer, Kind: "clusterissuer"},
+				},
+			}
+		}
+	}
+	migration := func(phase v1alpha1.CertificateMigrationPhase, source, target v1alpha1.CertificateProviderReference) *v1alpha1.CertificateMigrationStatus {
+		return &v1alpha1.CertificateMigrationStatus{Phase: phase, Source: source, Target: target}
+	}
+
+	tests := []struct {
+		name          string
+		esc           func(*v1alpha1.ExternalSecretsConfig)
+		migration     *v1alpha1.CertificateMigrationStatus
+		deployment    *appsv1.Deployment
+		secrets       []*corev1.Secret
+		wantMigration *v1alpha1.CertificateMigrationStatus
+		wantEvent     string
+		wantErr       string
+	}{
+		{
+			name: "webhook not deployed",
+			esc:  certManager("new-issuer"),
+		},
+		{
+			name:       "webhook using configured provider",
+			deployment: testWebhookDeployment(testCertControllerProvider, true),
+		},
+		{
+			name:       "webhook using cert-manager certificate not issued yet",
+			esc:        certManager("new-issuer"),
+			deployment: testWebhookDeployment(testCertManagerProvider("new-issuer"), true),
+		},
+		{
+			name:          "migration from cert-controller to cert-manager started",
+			esc:           certManager("new-issuer"),
+			deployment:    testWebhookDeployment(testCertControllerProvider, true),
+			wantMigration: migration(v1alpha1.CertificateMigrationProvisioning, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+			wantEvent:     "Normal CertificateMigration webhook certificate migration from CertController to CertManager (ClusterIssuer/new-issuer): waiting for the new certificate to be issued",
+		},
+		{
+			name:          "migration to new issuer started",
+			esc:           certManager("new-issuer"),
+			deployment:    testWebhookDeployment(testCertManagerProvider("old-issuer"), true),
+			secrets:       []*corev1.Secret{testIssuedSecret(testCertManagerProvider("old-issuer"))},
+			wantMigration: migration(v1alpha1.CertificateMigrationProvisioning, testCertManagerProvider("old-issuer"), testCertManagerProvider("new-issuer")),
+			wantEvent:     "Normal CertificateMigration webhook certificate migration from CertManager (ClusterIssuer/old-issuer) to CertManager (ClusterIssuer/new-issuer): waiting for the new certificate to be issued",
+		},
+		{
+			name:          "migration to new issuer waiting for certificate to be reissued",
+			esc:           certManager("new-issuer"),
+			migration:     migration(v1alpha1.CertificateMigrationProvisioning, testCertManagerProvider("old-issuer"), testCertManagerProvider("new-issuer")),
+			deployment:    testWebhookDeployment(testCertManagerProvider("old-issuer"), true),
+			secrets:       []*corev1.Secret{testIssuedSecret(testCertManagerProvider("old-issuer"))},
+			wantMigration: migration(v1alpha1.CertificateMigrationProvisioning, testCertManagerProvider("old-issuer"), testCertManagerProvider("new-issuer")),
+		},
+		{
+			name:          "migration to cert-manager waiting for certificate to be issued",
+			esc:           certManager("new-issuer"),
+			migration:     migration(v1alpha1.CertificateMigrationProvisioning, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+			deployment:    testWebhookDeployment(testCertControllerProvider, true),
+			wantMigration: migration(v1alpha1.CertificateMigrationProvisioning, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+		},
+		{
+			name:          "migration to cert-manager switching on certificate issued",
+			esc:           certManager("new-issuer"),
+			migration:     migration(v1alpha1.CertificateMigrationProvisioning, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+			deployment:    testWebhookDeployment(testCertControllerProvider, true),
+			secrets:       []*corev1.Secret{testIssuedSecret(testCertManagerProvider("new-issuer"))},
+			wantMigration: migration(v1alpha1.CertificateMigrationSwitching, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+			wantEvent:     "Normal CertificateMigration webhook certificate migration from CertController to CertManager (ClusterIssuer/new-issuer): waiting for the rollout of the webhook using the new certificate",
+		},
+		{
+			name:          "migration to cert-manager waiting for webhook rollout",
+			esc:           certManager("new-issuer"),
+			migration:     migration(v1alpha1.CertificateMigrationSwitching, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+			deployment:    testWebhookDeployment(testCertManagerProvider("new-issuer"), false),
+			secrets:       []*corev1.Secret{testIssuedSecret(testCertManagerProvider("new-issuer"))},
+			wantMigration: migration(v1alpha1.CertificateMigrationSwitching, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+		},
+		{
+			name:          "migration to cert-manager completed on webhook rollout",
+			esc:           certManager("new-issuer"),
+			migration:     migration(v1alpha1.CertificateMigrationSwitching, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+			deployment:    testWebhookDeployment(testCertManagerProvider("new-issuer"), true),
+			secrets:       []*corev1.Secret{testIssuedSecret(testCertManagerProvider("new-issuer"))},
+			wantMigration: migration(v1alpha1.CertificateMigrationCompleted, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+			wantEvent:     "Normal CertificateMigration webhook certificate migration from CertController to CertManager (ClusterIssuer/new-issuer): webhook is using the new certificate",
+		},
+		{
+			name:       "migration to cert-manager cancelled before certificate issued",
+			migration:  migration(v1alpha1.CertificateMigrationProvisioning, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+			deployment: testWebhookDeployment(testCertControllerProvider, true),
+			wantEvent:  "Normal CertificateMigration webhook certificate migration to CertManager (ClusterIssuer/new-issuer) cancelled",
+		},
+		{
+			name: "migration to cert-manager changed to service-ca after webhook switched",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = &v1alpha1.CertProvidersConfig{
+					ServiceCA: &v1alpha1.ServiceCAConfig{Mode: v1alpha1.Enabled},
+				}
+			},
+			migration:     migration(v1alpha1.CertificateMigrationSwitching, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+			deployment:    testWebhookDeployment(testCertManagerProvider("new-issuer"), false),
+			wantMigration: migration(v1alpha1.CertificateMigrationProvisioning, testCertManagerProvider("new-issuer"), testServiceCAProvider),
+			wantEvent:     "Normal CertificateMigration webhook certificate migration from CertManager (ClusterIssuer/new-issuer) to ServiceCA: waiting for the new certificate to be issued",
+		},
+		{
+			name:      "fetching webhook deployment fails",
+			esc:       certManager("new-issuer"),
+			migration: migration(v1alpha1.CertificateMigrationCompleted, testCertControllerProvider, testCertManagerProvider("new-issuer")),
+			wantMigration: migration(v1alpha1.CertificateMigrationCompleted, testCertControllerProvider,
+				testCertManagerProvider("new-issuer")),
+			wantErr: "failed to fetch external-secrets/external-secrets-webhook deployment resource: test client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+				switch o := obj.(type) {
+				case *appsv1.Deployment:
+					if tt.wantErr != "" {
+						return false, commontest.TestClientError
+					}
+					if tt.deployment == nil || ns != client.ObjectKeyFromObject(tt.deployment) {
+						return false, nil
+					}
+					tt.deployment.DeepCopyInto(o)
+					return true, nil
+				case *corev1.Secret:
+					for _, secret := range tt.secrets {
+						if ns == client.ObjectKeyFromObject(secret) {
+							secret.DeepCopyInto(o)
+							return true, nil
+						}
+					}
+				}
+				return false, nil
+			})
+			r.CtrlClient = mock
+			r.UncachedClient = mock
+			esc := commontest.TestExternalSecretsConfig()
+			if tt.esc != nil {
+				tt.esc(esc)
+			}
+			esc.Status.CertificateMigration = tt.migration.DeepCopy()
+
+			err := r.reconcileCertificateMigration(esc)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("reconcileCertificateMigration() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+
+			got := esc.Status.CertificateMigration.DeepCopy()
+			if got != nil {
+				if tt.wantEvent != "" && (got.Message == "" || got.LastTransitionTime.IsZero()) {
+					t.Errorf("reconcileCertificateMigration() migration message and transition time not set: %+v", got)
+				}
+				got.Message, got.LastTransitionTime = "", metav1.Time{}
+			}
+			if !reflect.DeepEqual(got, tt.wantMigration) {
+				t.Errorf("reconcileCertificateMigration() migration: %+v, want: %+v", got, tt.wantMigration)
+			}
+			assertEvent(t, r, tt.wantEvent)
+		})
+	}
+}
+
+func TestWebhookCertificateDuringMigration(t *testing.T) {
+	r := testReconciler(t)
+	t.Setenv("RELATED_IMAGE_EXTERNAL_SECRETS", commontest.TestExternalSecretsImageName)
+	t.Setenv("RELATED_IMAGE_BITWARDEN_SDK_SERVER", commontest.TestBitwardenImageName)
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ControllerConfig.CertProvider = &v1alpha1.CertProvidersConfig{
+		CertManager: &v1alpha1.CertManagerConfig{
+			Mode:              v1alpha1.Enabled,
+			InjectAnnotations: "true",
+			IssuerRef:         &v1alpha1.ObjectReference{Name: "new-issuer", Kind: "ClusterIssuer"},
+		},
+	}
+
+	tests := []struct {
+		phase          v1alpha1.CertificateMigrationPhase
+		wantSecretName string
+		wantInjectCA   bool
+	}{
+		{phase: v1alpha1.CertificateMigrationProvisioning, wantSecretName: certControllerTLSSecretWebhook},
+		{phase: v1alpha1.CertificateMigrationSwitching, wantSecretName: certmanagerTLSSecretWebhook, wantInjectCA: true},
+		{phase: v1alpha1.CertificateMigrationCompleted, wantSecretName: certmanagerTLSSecretWebhook, wantInjectCA: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.phase), func(t *testing.T) {
+			esc.Status.CertificateMigration = &v1alpha1.CertificateMigrationStatus{
+				Phase:  tt.phase,
+				Source: testCertControllerProvider,
+				Target: testCertManagerProvider("new-issuer"),
+			}
+
+			deployment, err := r.getDeploymentObject(webhookDeploymentAssetName, esc, controllerDefaultResourceLabels)
+			if err != nil {
+				t.Fatalf("getDeploymentObject() err: %v", err)
+			}
+			for _, volume := range deployment.Spec.Template.Spec.Volumes {
+				if volume.Name == "certs" && (volume.Secret == nil || volume.Secret.SecretName != tt.wantSecretName) {
+					t.Errorf("getDeploymentObject() certs volume: %+v, want secret %s", volume.VolumeSource, tt.wantSecretName)
+				}
+			}
+
+			webhooks, err := r.getValidatingWebhookObjects(esc, controllerDefaultResourceLabels)
+			if err != nil {
+				t.Fatalf("getValidatingWebhookObjects() err: %v", err)
+			}
+			for _, wh := range webhooks {
+				if _, ok := wh.GetAnnotations()[common.CertManagerInjectCAFromAnnotation]; ok != tt.wantInjectCA {
+					t.Errorf("getValidatingWebhookObjects() %s inject-ca-from annotation present: %v, want: %v", wh.GetName(), ok, tt.wantInjectCA)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package external_secrets
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -10,12 +11,15 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmetav1 "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 
 	"github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
 	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
 )
 
@@ -25,11 +29,12 @@ var (
 
 func TestCreateOrApplyCertificates(t *testing.T) {
 	tests := []struct {
-		name    string
-		preReq  func(*Reconciler, *fakes.FakeCtrlClient)
-		esc     func(*v1alpha1.ExternalSecretsConfig)
-		recon   bool
-		wantErr string
+		name      string
+		preReq    func(*Reconciler, *fakes.FakeCtrlClient)
+		esc       func(*v1alpha1.ExternalSecretsConfig)
+		recon     bool
+		wantErr   string
+		wantCause common.ErrorCause
 	}{
 		{
 			name:   "external secret spec disabled",
@@ -165,7 +170,7 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 					}
 					return false, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if obj.GetName() == serviceExternalSecretWebhookName {
 						return commontest.TestClientError
 					}
@@ -177,7 +182,7 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 				esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Name = "test-issuer"
 			},
 			recon:   false,
-			wantErr: fmt.Sprintf("failed to update %s/%s certificate resource: %s", commontest.TestExternalSecretsNamespace, testValidateCertificateResourceName, commontest.TestClientError),
+			wantErr: fmt.Sprintf("failed to apply %s/%s certificate resource: %s", commontest.TestExternalSecretsNamespace, testValidateCertificateResourceName, commontest.TestClientError),
 		},
 		{
 			name: "reconciliation of webhook certificate which already exists in expected state",
@@ -219,11 +224,11 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 					}
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					t.Errorf("Create was called unexpectedly for %s", obj.GetName())
 					return nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					return nil
 				})
 			},
@@ -233,6 +238,19 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 			},
 			recon: false,
 		},
+		{
+			name: "cert manager config enabled but issuer does not exist",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, nil)
+			},
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.CertManager.Mode = v1alpha1.Enabled
+				esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Name = "test-issuer"
+			},
+			recon:     false,
+			wantErr:   fmt.Sprintf("failed to update certificate resource for %s/%s deployment: failed to fetch issuer: Issuer \"test-issuer\" does not exist", commontest.TestExternalSecretsNamespace, testExternalSecretsConfigForCertificate().GetName()),
+			wantCause: common.IssuerNotFound,
+		},
 		{
 			name: "reconciliation of webhook certificate creation fails",
 			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
@@ -245,7 +263,7 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 					}
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if obj.GetName() == serviceExternalSecretWebhookName {
 						return commontest.TestClientError
 					}
@@ -264,7 +282,7 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 				esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Name = "test-issuer"
 			},
 			recon:   false,
-			wantErr: fmt.Sprintf("failed to create %s/%s certificate resource: %s", commontest.TestExternalSecretsNamespace, testValidateCertificateResourceName, commontest.TestClientError),
+			wantErr: fmt.Sprintf("failed to apply %s/%s certificate resource: %s", commontest.TestExternalSecretsNamespace, testValidateCertificateResourceName, commontest.TestClientError),
 		},
 		{
 			name: "successful webhook certificate creation",
@@ -278,7 +296,7 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 					}
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if obj.GetName() == serviceExternalSecretWebhookName {
 						return nil
 					}
@@ -330,12 +348,10 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 					}
 					return fmt.Errorf("object not found for %s/%s", ns.Namespace, ns.Name)
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
-					t.Errorf("Create was called for %s when SecretRef exists and assertion should return early", obj.GetName())
-					return nil
-				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
-					t.Errorf("UpdateWithRetry was called unexpectedly for %s", obj.GetName())
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					if obj.GetName() != serviceExternalSecretWebhookName {
+						t.Errorf("Apply was called for %s when SecretRef exists and assertion should return early", obj.GetName())
+					}
 					return nil
 				})
 			},
@@ -382,8 +398,10 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 					}
 					return fmt.Errorf("object not found")
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
-					t.Errorf("Create was called when SecretRef assertion should have failed and returned early")
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					if obj.GetName() != serviceExternalSecretWebhookName {
+						t.Errorf("Apply was called for %s when SecretRef assertion should have failed and returned early", obj.GetName())
+					}
 					return nil
 				})
 			},
@@ -415,7 +433,7 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 					}
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					cert, ok := obj.(*certmanagerv1.Certificate)
 					if !ok {
 						return fmt.Errorf("expected *certmanagerv1.Certificate, got %T", obj)
@@ -441,6 +459,27 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 			},
 			recon: false,
 		},
+		{
+			name: "bitwarden enabled without secretRef and cert-manager",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.Plugins.BitwardenSecretManagerProvider.Mode = v1alpha1.Enabled
+			},
+			wantErr:   "either secretRef, certManagerConfig or serviceCAConfig must be configured, when bitwardenSecretManagerProvider is enabled: invalid bitwardenSecretManagerProvider config",
+			wantCause: common.InvalidConfiguration,
+		},
+		{
+			name: "bitwarden enabled with service-ca: no certificates reconciled",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					t.Errorf("Unexpected apply call for %s", obj.GetName())
+					return nil
+				})
+			},
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.ServiceCA = &v1alpha1.ServiceCAConfig{Mode: v1alpha1.Enabled}
+				esc.Spec.Plugins.BitwardenSecretManagerProvider.Mode = v1alpha1.Enabled
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -462,6 +501,125 @@ func TestCreateOrApplyCertificates(t *testing.T) {
 			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
 				t.Errorf("createOrApplyCertificates() err: %v, wantErr: %v", err, tt.wantErr)
 			}
+			if cause := common.GetErrorCause(err); cause != tt.wantCause {
+				t.Errorf("createOrApplyCertificates() cause: %q, wantCause: %q", cause, tt.wantCause)
+			}
+		})
+	}
+}
+
+func TestCertificateCustomization(t *testing.T) {
+	tests := []struct {
+		name           string
+		fileName       string
+		config         func(*v1alpha1.CertManagerConfig)
+		wantPrivateKey *certmanagerv1.CertificatePrivateKey
+		wantSubject    *certmanagerv1.X509Subject
+		wantUsages     []certmanagerv1.KeyUsage
+		wantTemplate   *certmanagerv1.CertificateSecretTemplate
+		wantErr        string
+	}{
+		{
+			name:           "webhook certificate without customization",
+			fileName:       webhookCertificateAssetName,
+			config:         func(*v1alpha1.CertManagerConfig) {},
+			wantPrivateKey: testCertificate(webhookCertificateAssetName).Spec.PrivateKey,
+			wantUsages:     testCertificate(webhookCertificateAssetName).Spec.Usages,
+		},
+		{
+			name:     "webhook certificate with private key, subject, usages and secret template configured",
+			fileName: webhookCertificateAssetName,
+			config: func(c *v1alpha1.CertManagerConfig) {
+				c.PrivateKey = &v1alpha1.CertificatePrivateKey{
+					Algorithm:      v1alpha1.ECDSAKeyAlgorithm,
+					Size:           384,
+					RotationPolicy: v1alpha1.RotationPolicyAlways,
+				}
+				c.Subject = &v1alpha1.CertificateSubject{
+					Organizations: []string{"test-org"},
+					Countries:     []string{"IN"},
+				}
+				c.Usages = []v1alpha1.KeyUsage{"server auth", "digital signature"}
+				c.SecretTemplate = &v1alpha1.CertificateSecretTemplate{
+					Labels:      map[string]string{"test": "label", "app": "test"},
+					Annotations: map[string]string{"test": "annotation"},
+				}
+			},
+			wantPrivateKey: &certmanagerv1.CertificatePrivateKey{
+				Algorithm:      certmanagerv1.ECDSAKeyAlgorithm,
+				Size:           384,
+				RotationPolicy: certmanagerv1.RotationPolicyAlways,
+			},
+			wantSubject: &certmanagerv1.X509Subject{
+				Organizations: []string{"test-org"},
+				Countries:     []string{"IN"},
+			},
+			wantUsages: []certmanagerv1.KeyUsage{certmanagerv1.UsageServerAuth, certmanagerv1.UsageDigitalSignature},
+			wantTemplate: &certmanagerv1.CertificateSecretTemplate{
+				Labels:      map[string]string{"test": "label"},
+				Annotations: map[string]string{"test": "annotation"},
+			},
+		},
+		{
+			name:     "bitwarden certificate retains encoding when only algorithm is configured",
+			fileName: bitwardenCertificateAssetName,
+			config: func(c *v1alpha1.CertManagerConfig) {
+				c.PrivateKey = &v1alpha1.CertificatePrivateKey{
+					Algorithm: v1alpha1.Ed25519KeyAlgorithm,
+				}
+			},
+			wantPrivateKey: &certmanagerv1.CertificatePrivateKey{
+				Algorithm: certmanagerv1.Ed25519KeyAlgorithm,
+				Encoding:  certmanagerv1.PKCS8,
+			},
+			wantUsages: testCertificate(bitwardenCertificateAssetName).Spec.Usages,
+		},
+		{
+			name:     "secret template with invalid label value",
+			fileName: webhookCertificateAssetName,
+			config: func(c *v1alpha1.CertManagerConfig) {
+				c.SecretTemplate = &v1alpha1.CertificateSecretTemplate{
+					Labels: map[string]string{"test": "invalid value"},
+				}
+			},
+			wantErr: fmt.Sprintf("failed to update certificate resource for %s/%s deployment: spec.controllerConfig.certProvider.certManager.secretTemplate.labels: Invalid value: \"invalid value\": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')", commontest.TestExternalSecretsNamespace, testExternalSecretsConfigForCertificate().GetName()),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+				return ns.Name == "test-issuer", nil
+			})
+			r.CtrlClient = mock
+			r.UncachedClient = mock
+
+			esc := testExternalSecretsConfigForCertificate()
+			esc.Spec.ControllerConfig.CertProvider.CertManager.Mode = v1alpha1.Enabled
+			esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Name = "test-issuer"
+			tt.config(esc.Spec.ControllerConfig.CertProvider.CertManager)
+
+			certificate, err := r.getCertificateObject(esc, controllerDefaultResourceLabels, tt.fileName)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("getCertificateObject() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if tt.wantErr != "" {
+				return
+			}
+			if !reflect.DeepEqual(certificate.Spec.PrivateKey, tt.wantPrivateKey) {
+				t.Errorf("getCertificateObject() privateKey: %+v, want: %+v", certificate.Spec.PrivateKey, tt.wantPrivateKey)
+			}
+			if !reflect.DeepEqual(certificate.Spec.Subject, tt.wantSubject) {
+				t.Errorf("getCertificateObject() subject: %+v, want: %+v", certificate.Spec.Subject, tt.wantSubject)
+			}
+			if !reflect.DeepEqual(certificate.Spec.Usages, tt.wantUsages) {
+				t.Errorf("getCertificateObject() usages: %v, want: %v", certificate.Spec.Usages, tt.wantUsages)
+			}
+			if !reflect.DeepEqual(certificate.Spec.SecretTemplate, tt.wantTemplate) {
+				t.Errorf("getCertificateObject() secretTemplate: %+v, want: %+v", certificate.Spec.SecretTemplate, tt.wantTemplate)
+			}
 		})
 	}
 }
@@ -527,3 +685,239 @@ func testSecretForCertificate() *corev1.Secret {
 		},
 	}
 }
+
+func testCertificateWithReady(status cmmetav1.ConditionStatus, failed bool) *certmanagerv1.Certificate {
+	cert := &certmanagerv1.Certificate{
+		Status: certmanagerv1.CertificateStatus{
+			Conditions: []certmanagerv1.CertificateCondition{
+				{Type: certmanagerv1.CertificateConditionReady, Status: status, Reason: "Issuing", Message: "test message"},
+			},
+		},
+	}
+	if failed {
+		cert.Status.LastFailureTime = ptr.To(metav1.Now())
+	}
+	return cert
+}
+
+func testIssuerWithReady(status cmmetav1.ConditionStatus) *certmanagerv1.Issuer {
+	issuer := testIssuer()
+	issuer.Status.Conditions = []certmanagerv1.IssuerCondition{
+		{Type: certmanagerv1.IssuerConditionReady, Status: status, Reason: "ErrRegisterACMEAccount", Message: "failed to register ACME account"},
+	}
+	return issuer
+}
+
+func TestGetCertificatesReadyCondition(t *testing.T) {
+	tests := []struct {
+		name        string
+		esc         func(*v1alpha1.ExternalSecretsConfig)
+		certificate *certmanagerv1.Certificate
+		issuer      certmanagerv1.GenericIssuer
+		existsErr   error
+		wantCond    bool
+		wantStatus  metav1.ConditionStatus
+		wantReason  string
+		wantMsg     string
+		wantErr     string
+	}{
+		{
+			name:     "cert-manager not configured",
+			esc:      func(esc *v1alpha1.ExternalSecretsConfig) {},
+			wantCond: false,
+		},
+		{
+			name:        "webhook certificate ready",
+			certificate: testCertificateWithReady(cmmetav1.ConditionTrue, false),
+			wantCond:    true,
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  v1alpha1.ReasonReady,
+			wantMsg:     "certificates are ready",
+		},
+		{
+			name:       "webhook certificate does not exist",
+			wantCond:   true,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha1.ReasonInProgress,
+			wantMsg:    fmt.Sprintf("certificate %s/external-secrets-webhook does not exist", commontest.TestExternalSecretsNamespace),
+		},
+		{
+			name:        "webhook certificate being issued",
+			certificate: testCertificateWithReady(cmmetav1.ConditionFalse, false),
+			wantCond:    true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  v1alpha1.ReasonInProgress,
+			wantMsg:     fmt.Sprintf("certificate %s/external-secrets-webhook is not ready: Issuing: test message", commontest.TestExternalSecretsNamespace),
+		},
+		{
+			name:        "webhook certificate issuance failed",
+			certificate: testCertificateWithReady(cmmetav1.ConditionFalse, true),
+			wantCond:    true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  v1alpha1.ReasonFailed,
+			wantMsg:     fmt.Sprintf("certificate %s/external-secrets-webhook is not ready: Issuing: test message", commontest.TestExternalSecretsNamespace),
+		},
+		{
+			name: "bitwarden certificate not ready",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.CertManager.Mode = v1alpha1.Enabled
+				esc.Spec.Plugins.BitwardenSecretManagerProvider.Mode = v1alpha1.Enabled
+			},
+			certificate: &certmanagerv1.Certificate{},
+			wantCond:    true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  v1alpha1.ReasonInProgress,
+			wantMsg: fmt.Sprintf("certificate %[1]s/external-secrets-webhook is not ready: waiting for the certificate to be issued; "+
+				"certificate %[1]s/bitwarden-tls-certs is not ready: waiting for the certificate to be issued", commontest.TestExternalSecretsNamespace),
+		},
+		{
+			name: "webhook certificate issuance failed with issuing condition",
+			certificate: func() *certmanagerv1.Certificate {
+				cert := testCertificateWithReady(cmmetav1.ConditionFalse, true)
+				cert.Status.Conditions = append(cert.Status.Conditions, certmanagerv1.CertificateCondition{
+					Type: certmanagerv1.CertificateConditionIssuing, Status: cmmetav1.ConditionFalse, Reason: "Failed", Message: "acme challenge failed",
+				})
+				return cert
+			}(),
+			wantCond:   true,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha1.ReasonFailed,
+			wantMsg:    fmt.Sprintf("certificate %s/external-secrets-webhook is not ready: Failed: acme challenge failed", commontest.TestExternalSecretsNamespace),
+		},
+		{
+			name: "referenced issuer ready",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.CertManager.Mode = v1alpha1.Enabled
+				esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Name = "test-issuer"
+			},
+			certificate: testCertificateWithReady(cmmetav1.ConditionTrue, false),
+			issuer:      testIssuerWithReady(cmmetav1.ConditionTrue),
+			wantCond:    true,
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  v1alpha1.ReasonReady,
+			wantMsg:     "certificates are ready",
+		},
+		{
+			name: "referenced issuer not ready",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.CertManager.Mode = v1alpha1.Enabled
+				esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Name = "test-issuer"
+			},
+			certificate: testCertificateWithReady(cmmetav1.ConditionFalse, false),
+			issuer:      testIssuerWithReady(cmmetav1.ConditionFalse),
+			wantCond:    true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  v1alpha1.ReasonFailed,
+			wantMsg: fmt.Sprintf("Issuer \"test-issuer\" is not ready: ErrRegisterACMEAccount: failed to register ACME account; "+
+				"certificate %s/external-secrets-webhook is not ready: Issuing: test message", commontest.TestExternalSecretsNamespace),
+		},
+		{
+			name: "referenced cluster issuer not ready",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.CertManager.Mode = v1alpha1.Enabled
+				esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Name = "test-issuer"
+				esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Kind = "ClusterIssuer"
+			},
+			certificate: testCertificateWithReady(cmmetav1.ConditionTrue, false),
+			issuer: func() *certmanagerv1.ClusterIssuer {
+				issuer := testClusterIssuer()
+				issuer.Status.Conditions = testIssuerWithReady(cmmetav1.ConditionFalse).Status.Conditions
+				return issuer
+			}(),
+			wantCond:   true,
+			wantStatus: metav1.ConditionFalse,
+			wantReason: v1alpha1.ReasonFailed,
+			wantMsg:    `ClusterIssuer "test-issuer" is not ready: ErrRegisterACMEAccount: failed to register ACME account`,
+		},
+		{
+			name: "referenced issuer yet to be ready",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.CertManager.Mode = v1alpha1.Enabled
+				esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Name = "test-issuer"
+			},
+			certificate: testCertificateWithReady(cmmetav1.ConditionTrue, false),
+			issuer:      testIssuer(),
+			wantCond:    true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  v1alpha1.ReasonInProgress,
+			wantMsg:     `Issuer "test-issuer" is not ready: waiting for the issuer to be ready`,
+		},
+		{
+			name: "referenced issuer does not exist",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.CertManager.Mode = v1alpha1.Enabled
+				esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Name = "test-issuer"
+			},
+			certificate: testCertificateWithReady(cmmetav1.ConditionTrue, false),
+			wantCond:    true,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  v1alpha1.ReasonInProgress,
+			wantMsg:     `Issuer "test-issuer" does not exist`,
+		},
+		{
+			name:      "fetching certificate fails",
+			existsErr: commontest.TestClientError,
+			wantErr:   fmt.Sprintf("failed to fetch %s/external-secrets-webhook certificate resource: %s", commontest.TestExternalSecretsNamespace, commontest.TestClientError),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+				if tt.existsErr != nil {
+					return false, tt.existsErr
+				}
+				switch o := obj.(type) {
+				case *certmanagerv1.Issuer:
+					issuer, ok := tt.issuer.(*certmanagerv1.Issuer)
+					if !ok || ns.Namespace != commontest.TestExternalSecretsNamespace {
+						return false, nil
+					}
+					issuer.DeepCopyInto(o)
+					return true, nil
+				case *certmanagerv1.ClusterIssuer:
+					issuer, ok := tt.issuer.(*certmanagerv1.ClusterIssuer)
+					if !ok || ns.Namespace != "" {
+						return false, nil
+					}
+					issuer.DeepCopyInto(o)
+					return true, nil
+				case *certmanagerv1.Certificate:
+					if tt.certificate == nil {
+						return false, nil
+					}
+					tt.certificate.DeepCopyInto(o)
+					return true, nil
+				}
+				return false, nil
+			})
+
+			esc := testExternalSecretsConfigForCertificate()
+			if tt.esc != nil {
+				tt.esc(esc)
+			} else {
+				esc.Spec.ControllerConfig.CertProvider.CertManager.Mode = v1alpha1.Enabled
+			}
+
+			cond, err := r.getCertificatesReadyCondition(esc)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("getCertificatesReadyCondition() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if (cond != nil) != tt.wantCond {
+				t.Fatalf("getCertificatesReadyCondition() got: %v, wantCond: %v", cond, tt.wantCond)
+			}
+			if cond == nil {
+				return
+			}
+			if cond.Status != tt.wantStatus || cond.Reason != tt.wantReason || cond.Message != tt.wantMsg {
+				t.Errorf("getCertificatesReadyCondition() got: %s/%s/%q, want: %s/%s/%q", cond.Status, cond.Reason, cond.Message, tt.wantStatus, tt.wantReason, tt.wantMsg)
+			}
+		})
+	}
+}
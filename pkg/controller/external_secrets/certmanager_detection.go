@@ -0,0 +1,171 @@
+package external_secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	crdv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	operatorclient "github.com/openshift/external-secrets-operator/pkg/controller/client"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+)
+
+// certManagerWatchedObjects returns the cert-manager objects watched by the controller, when cert-manager is installed.
+// The Issuer resources are watched only in the operand namespace, and are not included.
+func certManagerWatchedObjects() []client.Object {
+	return []client.Object{&certmanagerv1.Certificate{}, &certmanagerv1.ClusterIssuer{}}
+}
+
+// syncCertManagerInstallation is for detecting cert-manager being installed or removed after the
+// controller is started, based on the state of the Certificate CRD. The watches on the Certificate
+// and the issuer resources are added when installed, and the informers are removed when the CRD is deleted.
+// The Issuer resources are watched in the operand namespace, once cert-manager is installed.
+func (r *Reconciler) syncCertManagerInstallation(esc *operatorv1alpha1.ExternalSecretsConfig) error {
+	installed, err := r.isCRDEstablished(certificateCRDObjectName)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case installed && !r.IsCertManagerInstalled():
+		if r.controller != nil {
+			src := source.Kind[client.Object](r.cache, &certmanagerv1.Certificate{}, handler.EnqueueRequestsFromMapFunc(r.managedResourceMapFunc), managedResources)
+			if err := r.controller.Watch(src); err != nil {
+				return fmt.Errorf("failed to add watch on Certificate resources: %w", err)
+			}
+			src = source.Kind[client.Object](r.cache, &certmanagerv1.ClusterIssuer{}, handler.EnqueueRequestsFromMapFunc(r.issuerMapFunc))
+			if err := r.controller.Watch(src); err != nil {
+				return fmt.Errorf("failed to add watch on ClusterIssuer resources: %w", err)
+			}
+		}
+		r.optionalResourcesList[certificateCRDGKV] = struct{}{}
+		r.log.Info("cert-manager installation detected", "crd", certificateCRDObjectName)
+		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "CertManagerInstalled", "cert-manager installation detected, %s CRD is available", certificateCRDObjectName)
+	case !installed && r.IsCertManagerInstalled():
+		if r.cache != nil {
+			for _, obj := range append(certManagerWatchedObjects(), &certmanagerv1.Issuer{}) {
+				if err := r.cache.RemoveInformer(r.ctx, obj); err != nil {
+					return fmt.Errorf("failed to remove %T informer: %w", obj, err)
+				}
+			}
+		}
+		r.issuerWatchNamespace = ""
+		delete(r.optionalResourcesList, certificateCRDGKV)
+		r.log.Info("cert-manager removal detected", "crd", certificateCRDObjectName)
+		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "CertManagerRemoved", "cert-manager removal detected, %s CRD is not available", certificateCRDObjectName)
+	}
+
+	if !r.IsCertManagerInstalled() {
+		return nil
+	}
+	return r.syncIssuerWatch(esc)
+}
+
+// syncIssuerWatch adds the watch on the Issuer resources of the operand namespace, which is the only
+// namespace the referenced Issuer could be in, instead of caching the Issuer resources of all the
+// namespaces. The watch is replaced when the ExternalSecretsConfig is recreated with another namespace.
+func (r *Reconciler) syncIssuerWatch(esc *operatorv1alpha1.ExternalSecretsConfig) error {
+	namespace := getNamespace(esc)
+	if r.controller == nil || r.issuerWatchNamespace == namespace {
+		return nil
+	}
+	oc, ok := r.cache.(*optionalResourcesCache)
+	if !ok {
+		return nil
+	}
+
+	if err := oc.setNamespace(&certmanagerv1.Issuer{}, namespace); err != nil {
+		return fmt.Errorf("failed to restrict Issuer informer to %s namespace: %w", namespace, err)
+	}
+	src := source.Kind[client.Object](r.cache, &certmanagerv1.Issuer{}, handler.EnqueueRequestsFromMapFunc(r.issuerMapFunc))
+	if err := r.controller.Watch(src); err != nil {
+		return fmt.Errorf("failed to add watch on Issuer resources in %s namespace: %w", namespace, err)
+	}
+	r.issuerWatchNamespace = namespace
+	r.log.V(1).Info("watching Issuer resources", "namespace", namespace)
+	return nil
+}
+
+// issuerMapFunc enqueues the reconcile request for the events of the cert-manager issuers, when the
+// issuer is the one referenced in the externalsecretsconfigs.operator.openshift.io.
+func (r *Reconciler) issuerMapFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	esc := &operatorv1alpha1.ExternalSecretsConfig{}
+	if err := r.Get(ctx, types.NamespacedName{Name: common.ExternalSecretsConfigObjectName}, esc); err != nil {
+		r.log.V(4).Info("failed to fetch externalsecretsconfigs.operator.openshift.io, ignoring issuer event", "name", obj.GetName(), "error", err)
+		return []reconcile.Request{}
+	}
+	if !isCertManagerConfigEnabled(esc) || !isReferencedIssuer(esc, obj) {
+		r.log.V(4).Info("issuer not of interest, ignoring reconcile event", "object", fmt.Sprintf("%T", obj), "name", obj.GetName(), "namespace", obj.GetNamespace())
+		return []reconcile.Request{}
+	}
+	return r.externalSecretsConfigMapFunc(ctx, obj)
+}
+
+// isReferencedIssuer returns whether the passed Issuer or ClusterIssuer is the one configured for
+// issuing the certificates.
+func isReferencedIssuer(esc *operatorv1alpha1.ExternalSecretsConfig, obj client.Object) bool {
+	config := esc.Spec.ControllerConfig.CertProvider.CertManager
+	if config.IssuerRef == nil || config.IssuerRef.Name != obj.GetName() {
+		return false
+	}
+	issuerRef := getCertificateIssuerRef(config)
+	switch obj.(type) {
+	case *certmanagerv1.ClusterIssuer:
+		return strings.EqualFold(issuerRef.Kind, clusterIssuerKind)
+	case *certmanagerv1.Issuer:
+		return strings.EqualFold(issuerRef.Kind, issuerKind) && obj.GetNamespace() == getNamespace(esc)
+	}
+	return false
+}
+
+// isCRDEstablished returns whether the CRD of an optional resource, like the cert-manager Certificate CRD,
+// exists, and is ready to be served.
+func (r *Reconciler) isCRDEstablished(name string) (bool, error) {
+	return isCRDEstablished(r.ctx, r.CtrlClient, name)
+}
+
+// isCRDEstablished returns whether the named CRD exists, and is ready to be served, using the passed client.
+func isCRDEstablished(ctx context.Context, c operatorclient.CtrlClient, name string) (bool, error) {
+	crd := &crdv1.CustomResourceDefinition{}
+	exist, err := c.Exists(ctx, client.ObjectKey{Name: name}, crd)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch %s customresourcedefinition: %w", name, err)
+	}
+	if !exist || !crd.DeletionTimestamp.IsZero() {
+		return false, nil
+	}
+	for _, cond := range crd.Status.Conditions {
+		if cond.Type == crdv1.Established {
+			return cond.Status == crdv1.ConditionTrue, nil
+		}
+	}
+	return false, nil
+}
+
+// getCertManagerInstalledCondition returns the condition indicating whether cert-manager is installed.
+func (r *Reconciler) getCertManagerInstalledCondition(esc *operatorv1alpha1.ExternalSecretsConfig) metav1.Condition {
+	cond := metav1.Condition{
+		Type:               operatorv1alpha1.CertManagerInstalled,
+		Status:             metav1.ConditionTrue,
+		Reason:             operatorv1alpha1.ReasonInstalled,
+		Message:            fmt.Sprintf("%s CRD is available", certificateCRDObjectName),
+		ObservedGeneration: esc.GetGeneration(),
+	}
+	if !r.IsCertManagerInstalled() {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = operatorv1alpha1.ReasonNotInstalled
+		cond.Message = fmt.Sprintf("%s CRD is not available", certificateCRDObjectName)
+	}
+	return cond
+}
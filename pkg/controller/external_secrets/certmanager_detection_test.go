@@ -0,0 +1,195 @@
+package external_secrets
+
+import (
+	"context"
+	"testing"
+
+	crdv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+)
+
+func testCertificateCRD(established crdv1.ConditionStatus) *crdv1.CustomResourceDefinition {
+	return &crdv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: certificateCRDObjectName,
+		},
+		Status: crdv1.CustomResourceDefinitionStatus{
+			Conditions: []crdv1.CustomResourceDefinitionCondition{
+				{
+					Type:   crdv1.Established,
+					Status: established,
+				},
+			},
+		},
+	}
+}
+
+func TestSyncCertManagerInstallation(t *testing.T) {
+	tests := []struct {
+		name          string
+		crd           *crdv1.CustomResourceDefinition
+		installed     bool
+		existsErr     error
+		wantInstalled bool
+		wantErr       string
+	}{
+		{
+			name: "cert-manager not installed",
+		},
+		{
+			name:          "cert-manager installed after controller start",
+			crd:           testCertificateCRD(crdv1.ConditionTrue),
+			wantInstalled: true,
+		},
+		{
+			name: "cert-manager CRD not established yet",
+			crd:  testCertificateCRD(crdv1.ConditionFalse),
+		},
+		{
+			name:      "cert-manager removed after controller start",
+			installed: true,
+		},
+		{
+			name:          "cert-manager remains installed",
+			crd:           testCertificateCRD(crdv1.ConditionTrue),
+			installed:     true,
+			wantInstalled: true,
+		},
+		{
+			name:      "fetching CRD fails",
+			existsErr: commontest.TestClientError,
+			wantErr:   "failed to fetch certificates.cert-manager.io customresourcedefinition: test client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			mock.ExistsCalls(func(ctx context.Context, ns client.ObjectKey, obj client.Object) (bool, error) {
+				if tt.existsErr != nil {
+					return false, tt.existsErr
+				}
+				if tt.crd == nil {
+					return false, nil
+				}
+				tt.crd.DeepCopyInto(obj.(*crdv1.CustomResourceDefinition))
+				return true, nil
+			})
+			if tt.installed {
+				r.optionalResourcesList[certificateCRDGKV] = struct{}{}
+			}
+			esc := commontest.TestExternalSecretsConfig()
+
+			err := r.syncCertManagerInstallation(esc)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("syncCertManagerInstallation() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if got := r.IsCertManagerInstalled(); got != tt.wantInstalled {
+				t.Errorf("syncCertManagerInstallation() installed: %v, want: %v", got, tt.wantInstalled)
+			}
+
+			apimeta.SetStatusCondition(&esc.Status.Conditions, r.getCertManagerInstalledCondition(esc))
+			if got := apimeta.IsStatusConditionTrue(esc.Status.Conditions, v1alpha1.CertManagerInstalled); got != tt.wantInstalled {
+				t.Errorf("getCertManagerInstalledCondition() status: %v, want: %v", got, tt.wantInstalled)
+			}
+		})
+	}
+}
+
+func TestIssuerMapFunc(t *testing.T) {
+	tests := []struct {
+		name      string
+		esc       func(*v1alpha1.ExternalSecretsConfig)
+		issuer    client.Object
+		getErr    error
+		wantQueue bool
+	}{
+		{
+			name:      "referenced issuer in operand namespace",
+			issuer:    testIssuer(),
+			wantQueue: true,
+		},
+		{
+			name: "referenced cluster issuer",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Kind = "clusterissuer"
+			},
+			issuer:    testClusterIssuer(),
+			wantQueue: true,
+		},
+		{
+			name: "issuer with the name of referenced cluster issuer",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.CertManager.IssuerRef.Kind = "ClusterIssuer"
+			},
+			issuer: testIssuer(),
+		},
+		{
+			name: "referenced issuer name in another namespace",
+			issuer: func() client.Object {
+				issuer := testIssuer()
+				issuer.SetNamespace("other-ns")
+				return issuer
+			}(),
+		},
+		{
+			name: "issuer not referenced",
+			issuer: func() client.Object {
+				issuer := testIssuer()
+				issuer.SetName("other-issuer")
+				return issuer
+			}(),
+		},
+		{
+			name: "cert-manager not enabled",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.CertManager.Mode = v1alpha1.Disabled
+			},
+			issuer: testIssuer(),
+		},
+		{
+			name:   "fetching externalsecretsconfig fails",
+			issuer: testIssuer(),
+			getErr: commontest.TestClientError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ControllerConfig.CertProvider = &v1alpha1.CertProvidersConfig{
+				CertManager: &v1alpha1.CertManagerConfig{
+					Mode:      v1alpha1.Enabled,
+					IssuerRef: &v1alpha1.ObjectReference{Name: "test-issuer"},
+				},
+			}
+			if tt.esc != nil {
+				tt.esc(esc)
+			}
+			mock.GetCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+				if tt.getErr != nil {
+					return tt.getErr
+				}
+				esc.DeepCopyInto(obj.(*v1alpha1.ExternalSecretsConfig))
+				return nil
+			})
+
+			requests := r.issuerMapFunc(context.Background(), tt.issuer)
+			if (len(requests) != 0) != tt.wantQueue {
+				t.Errorf("issuerMapFunc() got: %v, wantQueue: %v", requests, tt.wantQueue)
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package external_secrets
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+)
+
+// applyConfigOverrides patches the desired state of the resource with the unsupportedConfigOverrides configured
+// for it, and records the hash of the patches applied in an annotation for identifying the revision of the overrides
+// on the resource. The overrides applied are recorded for reporting them in the UnsupportedConfigOverridesApplied condition.
+func (r *Reconciler) applyConfigOverrides(esc *operatorv1alpha1.ExternalSecretsConfig, obj client.Object) error {
+	kind := common.ObjectKind(obj)
+	key := client.ObjectKeyFromObject(obj)
+	resourceName := resourceDisplayName(strings.ToLower(kind), obj)
+
+	hash := sha256.New()
+	applied := false
+	for i, override := range esc.Spec.UnsupportedConfigOverrides {
+		if !strings.EqualFold(override.Kind, kind) || override.Name != obj.GetName() {
+			continue
+		}
+		if err := applyConfigOverride(obj, override); err != nil {
+			return common.NewIrrecoverableError(err, "failed to apply unsupportedConfigOverrides[%d] on %s", i, resourceName).WithCause(common.InvalidConfiguration)
+		}
+		if client.ObjectKeyFromObject(obj) != key {
+			return common.NewIrrecoverableError(fmt.Errorf("patch must not modify the name or the namespace"),
+				"failed to apply unsupportedConfigOverrides[%d] on %s", i, resourceName).WithCause(common.InvalidConfiguration)
+		}
+		if r.appliedConfigOverrides == nil {
+			r.appliedConfigOverrides = make(map[int]string)
+		}
+		r.appliedConfigOverrides[i] = resourceName
+		_, _ = fmt.Fprintf(hash, "%s\n%s\n", override.Type, override.Patch)
+		applied = true
+	}
+	if !applied {
+		return nil
+	}
+
+	r.log.V(4).Info("applied unsupported config overrides", "kind", kind, "name", key)
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[common.ConfigOverridesHashAnnotation] = fmt.Sprintf("%x", hash.Sum(nil))
+	obj.SetAnnotations(annotations)
+	return nil
+}
+
+// applyConfigOverride applies the patch of the override on the object in place.
+func applyConfigOverride(obj client.Object, override operatorv1alpha1.ConfigOverride) error {
+	patch, err := getConfigOverridePatch(override)
+	if err != nil {
+		return err
+	}
+	original, err := json.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("failed to encode %T object: %w", obj, err)
+	}
+
+	var patched []byte
+	_, isUnstructured := obj.(*unstructured.Unstructured)
+	switch {
+	case override.Type == operatorv1alpha1.JSONPatch:
+		jsonPatch, _ := jsonpatch.DecodePatch(patch)
+		patched, err = jsonPatch.Apply(original)
+	case isUnstructured:
+		// strategic merge patch requires the schema of the object, which is not available for the
+		// kinds not registered in the scheme.
+		patched, err = jsonpatch.MergePatch(original, patch)
+	default:
+		patched, err = strategicpatch.StrategicMergePatch(original, patch, obj)
+	}
+	if err != nil {
+		return err
+	}
+
+	// object is reset for the fields removed by the patch to not be retained on decoding.
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		u.Object = nil
+		return u.UnmarshalJSON(patched)
+	}
+	v := reflect.ValueOf(obj).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	return json.Unmarshal(patched, obj)
+}
+
+// getConfigOverridePatch returns the patch of the override converted to JSON, after validating
+// it is of the format expected for the patch type.
+func getConfigOverridePatch(override operatorv1alpha1.ConfigOverride) ([]byte, error) {
+	patch, err := utilyaml.ToJSON([]byte(override.Patch))
+	if err != nil {
+		return nil, fmt.Errorf("patch is neither valid JSON nor YAML: %w", err)
+	}
+	if override.Type == operatorv1alpha1.JSONPatch {
+		if _, err := jsonpatch.DecodePatch(patch); err != nil {
+			return nil, fmt.Errorf("patch is not a list of JSON patch operations: %w", err)
+		}
+		return patch, nil
+	}
+	if err := json.Unmarshal(patch, &map[string]any{}); err != nil {
+		return nil, fmt.Errorf("patch is not a JSON object: %w", err)
+	}
+	return patch, nil
+}
+
+// validateConfigOverrides validates the patches configured in unsupportedConfigOverrides can be decoded.
+func validateConfigOverrides(overrides []operatorv1alpha1.ConfigOverride, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, override := range overrides {
+		if _, err := getConfigOverridePatch(override); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Index(i).Child("patch"), override.Patch, err.Error()))
+		}
+	}
+	return errs
+}
+
+// getUnsupportedConfigOverridesCondition returns the condition warning that the operand resources are customized
+// with the unsupportedConfigOverrides, and nil when no overrides are configured.
+func (r *Reconciler) getUnsupportedConfigOverridesCondition(esc *operatorv1alpha1.ExternalSecretsConfig) *metav1.Condition {
+	if len(esc.Spec.UnsupportedConfigOverrides) == 0 {
+		return nil
+	}
+
+	var applied, unmatched []string
+	for i, override := range esc.Spec.UnsupportedConfigOverrides {
+		if resourceName, ok := r.appliedConfigOverrides[i]; ok {
+			applied = append(applied, resourceName)
+			continue
+		}
+		unmatched = append(unmatched, fmt.Sprintf("%s %s", strings.ToLower(override.Kind), override.Name))
+	}
+	slices.Sort(applied)
+	message := "operand resources are customized with unsupportedConfigOverrides, which is not supported"
+	if len(applied) != 0 {
+		message = fmt.Sprintf("%s; applied on: %s", message, strings.Join(slices.Compact(applied), ", "))
+	}
+	if len(unmatched) != 0 {
+		message = fmt.Sprintf("%s; not matching any resource: %s", message, strings.Join(unmatched, ", "))
+	}
+
+	return &metav1.Condition{
+		Type:               operatorv1alpha1.UnsupportedConfigOverridesApplied,
+		Status:             metav1.ConditionTrue,
+		Reason:             operatorv1alpha1.ReasonApplied,
+		Message:            message,
+		ObservedGeneration: esc.GetGeneration(),
+	}
+}
+
+// setUnsupportedConfigOverridesCondition updates the UnsupportedConfigOverridesApplied condition, which is
+// refreshed only when the resources were reconciled, since the overrides applied would be partial otherwise.
+func (r *Reconciler) setUnsupportedConfigOverridesCondition(esc *operatorv1alpha1.ExternalSecretsConfig, reconciled bool) {
+	cond := r.getUnsupportedConfigOverridesCondition(esc)
+	if cond == nil {
+		apimeta.RemoveStatusCondition(&esc.Status.Conditions, operatorv1alpha1.UnsupportedConfigOverridesApplied)
+		return
+	}
+	if !reconciled {
+		return
+	}
+	if prev := apimeta.FindStatusCondition(esc.Status.Conditions, cond.Type); prev == nil || prev.Message != cond.Message {
+		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "UnsupportedConfigOverrides", "%s", cond.Message)
+	}
+	apimeta.SetStatusCondition(&esc.Status.Conditions, *cond)
+}
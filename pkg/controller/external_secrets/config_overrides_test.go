@@ -0,0 +1,222 @@
+package external_secrets
+
+import (
+	"context"
+	"testing"
+
+	webhook "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+	"github.com/openshift/external-secrets-operator/pkg/operator/assets"
+)
+
+func TestApplyConfigOverrides(t *testing.T) {
+	tests := []struct {
+		name        string
+		object      func() client.Object
+		overrides   []operatorv1alpha1.ConfigOverride
+		wantApplied bool
+		validate    func(*testing.T, client.Object)
+		wantErr     string
+	}{
+		{
+			name: "strategic merge patch adding env var and host alias to deployment",
+			object: func() client.Object {
+				return common.DecodeDeploymentObjBytes(assets.MustAsset(controllerDeploymentAssetName))
+			},
+			overrides: []operatorv1alpha1.ConfigOverride{
+				{
+					Kind: "deployment",
+					Name: "external-secrets",
+					Patch: `spec:
+  template:
+    spec:
+      hostAliases:
+      - ip: 10.0.0.10
+        hostnames: ["vault.example.com"]
+      containers:
+      - name: external-secrets
+        env:
+        - name: GODEBUG
+          value: x509ignoreCN=0`,
+				},
+			},
+			wantApplied: true,
+			validate: func(t *testing.T, obj client.Object) {
+				deployment := obj.(*appsv1.Deployment)
+				spec := deployment.Spec.Template.Spec
+				if len(spec.HostAliases) != 1 || spec.HostAliases[0].IP != "10.0.0.10" {
+					t.Errorf("hostAliases: %v, want 10.0.0.10 entry", spec.HostAliases)
+				}
+				if len(spec.Containers) != 1 || len(spec.Containers[0].Args) == 0 {
+					t.Fatalf("containers not merged, got: %v", spec.Containers)
+				}
+				found := false
+				for _, env := range spec.Containers[0].Env {
+					found = found || env == corev1.EnvVar{Name: "GODEBUG", Value: "x509ignoreCN=0"}
+				}
+				if !found {
+					t.Errorf("env: %v, want GODEBUG env var", spec.Containers[0].Env)
+				}
+			},
+		},
+		{
+			name: "json patch replacing webhook timeout",
+			object: func() client.Object {
+				return testValidatingWebhookConfiguration(validatingWebhookSecretStoreCRDAssetName)
+			},
+			overrides: []operatorv1alpha1.ConfigOverride{
+				{
+					Kind:  "ValidatingWebhookConfiguration",
+					Name:  "secretstore-validate",
+					Type:  operatorv1alpha1.JSONPatch,
+					Patch: `[{"op": "replace", "path": "/webhooks/0/timeoutSeconds", "value": 15}]`,
+				},
+			},
+			wantApplied: true,
+			validate: func(t *testing.T, obj client.Object) {
+				webhooks := obj.(*webhook.ValidatingWebhookConfiguration).Webhooks
+				if *webhooks[0].TimeoutSeconds != 15 || *webhooks[1].TimeoutSeconds != 5 {
+					t.Errorf("timeoutSeconds: %d, %d, want: 15, 5", *webhooks[0].TimeoutSeconds, *webhooks[1].TimeoutSeconds)
+				}
+			},
+		},
+		{
+			name: "merge patch on servicemonitor",
+			object: func() client.Object {
+				return common.DecodeUnstructuredObjBytes(assets.MustAsset(metricsServiceMonitorAssetName))
+			},
+			overrides: []operatorv1alpha1.ConfigOverride{
+				{
+					Kind:  "ServiceMonitor",
+					Name:  "external-secrets-metrics",
+					Patch: `{"spec": {"jobLabel": "app"}}`,
+				},
+			},
+			wantApplied: true,
+			validate: func(t *testing.T, obj client.Object) {
+				jobLabel, _, _ := unstructured.NestedString(obj.(*unstructured.Unstructured).Object, "spec", "jobLabel")
+				if jobLabel != "app" {
+					t.Errorf("jobLabel: %q, want: app", jobLabel)
+				}
+			},
+		},
+		{
+			name: "override of other resource not applied",
+			object: func() client.Object {
+				return common.DecodeDeploymentObjBytes(assets.MustAsset(controllerDeploymentAssetName))
+			},
+			overrides: []operatorv1alpha1.ConfigOverride{
+				{Kind: "Deployment", Name: "external-secrets-webhook", Patch: `{"spec": {"paused": true}}`},
+				{Kind: "Service", Name: "external-secrets", Patch: `{"spec": {"type": "NodePort"}}`},
+			},
+		},
+		{
+			name: "json patch with missing path",
+			object: func() client.Object {
+				return common.DecodeDeploymentObjBytes(assets.MustAsset(controllerDeploymentAssetName))
+			},
+			overrides: []operatorv1alpha1.ConfigOverride{
+				{Kind: "Deployment", Name: "external-secrets", Type: operatorv1alpha1.JSONPatch, Patch: `[{"op": "replace", "path": "/spec/template/spec/hostNetwork/enabled", "value": true}]`},
+			},
+			wantErr: "failed to apply unsupportedConfigOverrides[0] on deployment external-secrets/external-secrets: replace operation does not apply: doc is missing path: /spec/template/spec/hostNetwork/enabled: missing value",
+		},
+		{
+			name: "patch renaming the resource",
+			object: func() client.Object {
+				return common.DecodeDeploymentObjBytes(assets.MustAsset(controllerDeploymentAssetName))
+			},
+			overrides: []operatorv1alpha1.ConfigOverride{
+				{Kind: "Deployment", Name: "external-secrets", Patch: `{"metadata": {"name": "external-secrets-custom"}}`},
+			},
+			wantErr: "failed to apply unsupportedConfigOverrides[0] on deployment external-secrets/external-secrets: patch must not modify the name or the namespace",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.UnsupportedConfigOverrides = tt.overrides
+
+			obj := tt.object()
+			err := r.applyConfigOverrides(esc, obj)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("applyConfigOverrides() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if !common.IsIrrecoverableError(err) {
+					t.Errorf("applyConfigOverrides() err: %v, want irrecoverable error", err)
+				}
+				return
+			}
+
+			if applied := obj.GetAnnotations()[common.ConfigOverridesHashAnnotation] != ""; applied != tt.wantApplied {
+				t.Errorf("applyConfigOverrides() hash annotation set: %v, want: %v", applied, tt.wantApplied)
+			}
+			if applied := len(r.appliedConfigOverrides) != 0; applied != tt.wantApplied {
+				t.Errorf("applyConfigOverrides() recorded overrides: %v, want applied: %v", r.appliedConfigOverrides, tt.wantApplied)
+			}
+			if tt.validate != nil {
+				tt.validate(t, obj)
+			}
+		})
+	}
+}
+
+func TestConfigOverridesChangeUpdatesResource(t *testing.T) {
+	r := testReconciler(t)
+	mock := &fakes.FakeCtrlClient{}
+	r.CtrlClient = mock
+	// existing resource is in the desired state, but without the override applied.
+	mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+		testPodDisruptionBudget(webhookPodDisruptionBudgetAssetName).DeepCopyInto(obj.(*policyv1.PodDisruptionBudget))
+		return true, nil
+	})
+
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ApplicationConfig.Replicas = &operatorv1alpha1.ComponentReplicas{Webhook: ptr.To(int32(2))}
+	esc.Spec.UnsupportedConfigOverrides = []operatorv1alpha1.ConfigOverride{
+		{Kind: "PodDisruptionBudget", Name: "external-secrets-webhook-pdb", Patch: `{"spec": {"unhealthyPodEvictionPolicy": "AlwaysAllow"}}`},
+	}
+
+	if err := r.createOrApplyPodDisruptionBudgets(esc, controllerDefaultResourceLabels, false); err != nil {
+		t.Fatalf("createOrApplyPodDisruptionBudgets() unexpected error: %v", err)
+	}
+	if mock.ApplyCallCount() != 1 {
+		t.Fatalf("createOrApplyPodDisruptionBudgets() applies: %d, want: 1", mock.ApplyCallCount())
+	}
+	_, obj, _ := mock.ApplyArgsForCall(0)
+	if policy := obj.(*policyv1.PodDisruptionBudget).Spec.UnhealthyPodEvictionPolicy; policy == nil || *policy != policyv1.AlwaysAllow {
+		t.Errorf("createOrApplyPodDisruptionBudgets() unhealthyPodEvictionPolicy: %v, want: %s", policy, policyv1.AlwaysAllow)
+	}
+}
+
+func TestGetUnsupportedConfigOverridesCondition(t *testing.T) {
+	r := testReconciler(t)
+	esc := commontest.TestExternalSecretsConfig()
+	if cond := r.getUnsupportedConfigOverridesCondition(esc); cond != nil {
+		t.Errorf("getUnsupportedConfigOverridesCondition() unexpected condition: %v", cond)
+	}
+
+	esc.Spec.UnsupportedConfigOverrides = []operatorv1alpha1.ConfigOverride{
+		{Kind: "Deployment", Name: "external-secrets", Patch: `{"spec": {"paused": true}}`},
+		{Kind: "Deployment", Name: "external-secret", Patch: `{"spec": {"paused": true}}`},
+	}
+	r.appliedConfigOverrides = map[int]string{0: "deployment external-secrets/external-secrets"}
+	want := "operand resources are customized with unsupportedConfigOverrides, which is not supported; applied on: deployment external-secrets/external-secrets; not matching any resource: deployment external-secret"
+	cond := r.getUnsupportedConfigOverridesCondition(esc)
+	if cond == nil || cond.Reason != operatorv1alpha1.ReasonApplied || cond.Message != want {
+		t.Errorf("getUnsupportedConfigOverridesCondition() condition: %v, want message: %s", cond, want)
+	}
+}
@@ -0,0 +1,221 @@
+package external_secrets
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/operator/assets"
+)
+
+// createOrApplyTrustedCABundleConfigMap is for creating the ConfigMap into which the OpenShift
+// cluster-wide trusted CA bundle is injected, when enabled in ExternalSecretsConfig.
+func (r *Reconciler) createOrApplyTrustedCABundleConfigMap(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string, recon bool) error {
+	if !isClusterTrustedCABundleEnabled(esc) {
+		r.log.V(4).Info("cluster trusted CA bundle is not enabled, skipping configmap resource creation")
+		return nil
+	}
+
+	// CA bundle is injected into the data by the cluster network operator, which is not owned by the operator.
+	desired := r.getTrustedCABundleConfigMapObject(esc, resourceLabels)
+	return r.applyResource(esc, desired, &corev1.ConfigMap{}, "configmap", recon)
+}
+
+func (r *Reconciler) getTrustedCABundleConfigMapObject(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string) *corev1.ConfigMap {
+	configMap := common.DecodeConfigMapObjBytes(assets.MustAsset(trustedCABundleConfigMapAssetName))
+	updateNamespace(configMap, esc)
+	common.UpdateResourceLabels(configMap, resourceLabels)
+	return configMap
+}
+
+// createOrApplyServiceCABundleConfigMap is for creating the ConfigMap into which the OpenShift service CA
+// bundle is injected, for the components to verify the serving certificates generated by service-ca.
+func (r *Reconciler) createOrApplyServiceCABundleConfigMap(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string, recon bool) error {
+	if !common.IsServiceCAConfigEnabled(esc) {
+		r.log.V(4).Info("service-ca config is not enabled, skipping service CA bundle configmap resource creation")
+		return nil
+	}
+
+	// CA bundle is injected into the data by the service-ca operator, which is not owned by the operator.
+	desired := r.getServiceCABundleConfigMapObject(esc, resourceLabels)
+	return r.applyResource(esc, desired, &corev1.ConfigMap{}, "configmap", recon)
+}
+
+func (r *Reconciler) getServiceCABundleConfigMapObject(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string) *corev1.ConfigMap {
+	configMap := common.DecodeConfigMapObjBytes(assets.MustAsset(serviceCABundleConfigMapAssetName))
+	updateNamespace(configMap, esc)
+	common.UpdateResourceLabels(configMap, resourceLabels)
+	return configMap
+}
+
+// getServiceCABundleConfigMapName returns the name of the ConfigMap into which the service CA bundle is injected.
+func getServiceCABundleConfigMapName() string {
+	return common.DecodeConfigMapObjBytes(assets.MustAsset(serviceCABundleConfigMapAssetName)).GetName()
+}
+
+// getTrustedCABundleHash returns the hash of the CA certificates configured to be trusted by the
+// operand containers, which is used for rolling out the operand pods when the certificates change.
+func (r *Reconciler) getTrustedCABundleHash(esc *operatorv1alpha1.ExternalSecretsConfig) (string, error) {
+	hash := sha256.New()
+
+	if isClusterTrustedCABundleEnabled(esc) {
+		// ConfigMap is created by the controller, and the CA bundle might not
+		// have been injected yet, which will be reconciled again once injected.
+		configMap := r.getTrustedCABundleConfigMapObject(esc, nil)
+		fetched := &corev1.ConfigMap{}
+		key := client.ObjectKeyFromObject(configMap)
+		if _, err := r.Exists(r.ctx, key, fetched); err != nil {
+			return "", common.FromClientError(err, "failed to fetch %s configmap resource", key)
+		}
+		hash.Write([]byte(fetched.Data[trustedCABundleKey]))
+	}
+
+	if ref := getTrustedCABundleConfigMapRef(esc); ref != nil {
+		bundle, err := r.getUserTrustedCABundle(esc, ref)
+		if err != nil {
+			return "", err
+		}
+		hash.Write([]byte(bundle))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// getUserTrustedCABundle returns the validated CA certificates from the user provided ConfigMap. The
+// ConfigMap is not created by the controller, hence is read from the dedicated cache added by
+// syncTrustedCABundleWatch, instead of the manager cache which has only the labelled resources.
+func (r *Reconciler) getUserTrustedCABundle(esc *operatorv1alpha1.ExternalSecretsConfig, ref *operatorv1alpha1.ConfigMapKeyReference) (string, error) {
+	key := client.ObjectKey{Namespace: getNamespace(esc), Name: ref.Name}
+	if r.trustedCABundleCache == nil || r.trustedCABundleNamespace != key.Namespace {
+		return "", common.NewRetryRequiredError(fmt.Errorf("configmap is not watched"), "failed to fetch %s trusted CA bundle configmap", key)
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := r.trustedCABundleCache.Get(r.ctx, key, configMap); err != nil {
+		if errors.IsNotFound(err) {
+			return "", common.NewRetryRequiredError(fmt.Errorf("configmap does not exist"), "failed to fetch %s trusted CA bundle configmap", key)
+		}
+		return "", common.FromClientError(err, "failed to fetch %s trusted CA bundle configmap", key)
+	}
+
+	bundle, ok := configMap.Data[getTrustedCABundleKey(ref)]
+	if !ok {
+		return "", common.NewRetryRequiredError(fmt.Errorf("%q key does not exist", getTrustedCABundleKey(ref)), "failed to read CA certificates from %s configmap", key)
+	}
+	if err := validateCABundle([]byte(bundle)); err != nil {
+		return "", common.NewIrrecoverableError(err, "invalid CA certificates in %s configmap", key)
+	}
+
+	return bundle, nil
+}
+
+// syncTrustedCABundleWatch adds the watch on the user provided trusted CA bundle ConfigMap, for the
+// changes in it to be rolled out to the operand. The ConfigMap is not labelled like the resources created
+// by the controller, and is instead read from a dedicated cache of the ConfigMaps in the operand namespace,
+// the events of which are filtered by the configured name and sent to the trustedCABundleEvents source
+// added when the controller is set up. The cache is replaced only when the operand namespace changes, and
+// is stopped when the configMapRef is removed.
+func (r *Reconciler) syncTrustedCABundleWatch(esc *operatorv1alpha1.ExternalSecretsConfig) error {
+	var namespace, name string
+	if ref := getTrustedCABundleConfigMapRef(esc); ref != nil {
+		namespace, name = getNamespace(esc), ref.Name
+	}
+	r.trustedCABundleName.Store(name)
+	if r.trustedCABundleEvents == nil || r.trustedCABundleNamespace == namespace {
+		return nil
+	}
+
+	if r.stopTrustedCABundleCache != nil {
+		r.stopTrustedCABundleCache()
+		r.stopTrustedCABundleCache = nil
+	}
+	r.trustedCABundleCache, r.trustedCABundleNamespace = nil, ""
+	if namespace == "" {
+		return nil
+	}
+
+	c, err := r.newCache(cache.Options{
+		ByObject: map[client.Object]cache.ByObject{
+			&corev1.ConfigMap{}: {
+				Namespaces: map[string]cache.Config{namespace: {}},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create cache for trusted CA bundle configmap in %s namespace: %w", namespace, err)
+	}
+	informer, err := c.GetInformer(r.ctx, &corev1.ConfigMap{}, cache.BlockUntilSynced(false))
+	if err != nil {
+		return fmt.Errorf("failed to get informer for trusted CA bundle configmap in %s namespace: %w", namespace, err)
+	}
+	if _, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    r.enqueueTrustedCABundleEvent,
+		UpdateFunc: func(_, obj any) { r.enqueueTrustedCABundleEvent(obj) },
+		DeleteFunc: r.enqueueTrustedCABundleEvent,
+	}); err != nil {
+		return fmt.Errorf("failed to add watch on trusted CA bundle configmap in %s namespace: %w", namespace, err)
+	}
+	ctx, cancel := context.WithCancel(r.ctx)
+	go func() {
+		if err := c.Start(ctx); err != nil {
+			r.log.Error(err, "failed to start cache of trusted CA bundle configmap", "namespace", namespace)
+		}
+	}()
+
+	r.trustedCABundleCache, r.trustedCABundleNamespace, r.stopTrustedCABundleCache = c, namespace, cancel
+	r.log.V(1).Info("watching trusted CA bundle configmap", "namespace", namespace, "name", name)
+	return nil
+}
+
+// enqueueTrustedCABundleEvent sends the event of the ConfigMap to the trustedCABundleEvents source, when
+// it is the user provided trusted CA bundle ConfigMap.
+func (r *Reconciler) enqueueTrustedCABundleEvent(obj any) {
+	if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	configMap, ok := obj.(client.Object)
+	if !ok {
+		return
+	}
+	if name, _ := r.trustedCABundleName.Load().(string); name == "" || configMap.GetName() != name {
+		return
+	}
+	select {
+	case r.trustedCABundleEvents <- event.GenericEvent{Object: configMap}:
+	case <-r.ctx.Done():
+	}
+}
+
+// validateCABundle checks that the bundle has only the PEM encoded certificates, and at least one of them.
+func validateCABundle(bundle []byte) error {
+	count := 0
+	for {
+		var block *pem.Block
+		block, bundle = pem.Decode(bundle)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			return fmt.Errorf("unexpected %q PEM block, only CERTIFICATE is allowed", block.Type)
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return fmt.Errorf("failed to parse certificate: %w", err)
+		}
+		count++
+	}
+	if count == 0 {
+		return fmt.Errorf("no PEM encoded certificates found")
+	}
+	return nil
+}
@@ -0,0 +1,570 @@
+package external_secrets
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+)
+
+const (
+	testTrustedCABundleConfigMapName = "external-secrets-trusted-ca-bundle"
+	testUserCABundleConfigMapName    = "vault-ca"
+)
+
+// testCABundle returns a PEM encoded self-signed CA certificate.
+func testCABundle(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestCreateOrApplyTrustedCABundleConfigMap(t *testing.T) {
+	tests := []struct {
+		name      string
+		preReq    func(*Reconciler, *fakes.FakeCtrlClient)
+		esc       func(*v1alpha1.ExternalSecretsConfig)
+		wantApply bool
+		wantEvent string
+		wantErr   string
+	}{
+		{
+			name: "cluster trusted CA bundle not enabled",
+		},
+		{
+			name: "configmap created",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ApplicationConfig.TrustedCABundle = &v1alpha1.TrustedCABundleConfig{ClusterTrustedCABundle: v1alpha1.Enabled}
+			},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, nil)
+			},
+			wantApply: true,
+			wantEvent: "Normal Reconciled configmap resource external-secrets/external-secrets-trusted-ca-bundle created",
+		},
+		{
+			name: "configmap labels modified, injected data not managed",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ApplicationConfig.TrustedCABundle = &v1alpha1.TrustedCABundleConfig{ClusterTrustedCABundle: v1alpha1.Enabled}
+			},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+					if o, ok := obj.(*corev1.ConfigMap); ok {
+						o.SetName(ns.Name)
+						o.SetNamespace(ns.Namespace)
+						o.SetLabels(map[string]string{"app": "external-secrets"})
+						o.SetResourceVersion("1")
+						o.Data = map[string]string{trustedCABundleKey: "injected"}
+					}
+					return true, nil
+				})
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					if o, ok := obj.(*corev1.ConfigMap); ok && len(o.Data) != 0 {
+						t.Errorf("injected CA bundle must not be part of the applied configuration: %v", o.Data)
+					}
+					obj.SetResourceVersion("2")
+					return nil
+				})
+			},
+			wantApply: true,
+			wantEvent: "Normal Reconciled configmap resource external-secrets/external-secrets-trusted-ca-bundle reconciled back to desired state",
+		},
+		{
+			name: "configmap in desired state",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ApplicationConfig.TrustedCABundle = &v1alpha1.TrustedCABundleConfig{ClusterTrustedCABundle: v1alpha1.Enabled}
+			},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+					if o, ok := obj.(*corev1.ConfigMap); ok {
+						r.getTrustedCABundleConfigMapObject(commontest.TestExternalSecretsConfig(), controllerDefaultResourceLabels).DeepCopyInto(o)
+					}
+					return true, nil
+				})
+			},
+			wantApply: true,
+		},
+		{
+			name: "configmap existence check fails",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ApplicationConfig.TrustedCABundle = &v1alpha1.TrustedCABundleConfig{ClusterTrustedCABundle: v1alpha1.Enabled}
+			},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, commontest.TestClientError)
+			},
+			wantErr: "failed to check external-secrets/external-secrets-trusted-ca-bundle configmap resource already exists: test client error",
+		},
+		{
+			name: "configmap creation fails",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ApplicationConfig.TrustedCABundle = &v1alpha1.TrustedCABundleConfig{ClusterTrustedCABundle: v1alpha1.Enabled}
+			},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, nil)
+				m.ApplyReturns(commontest.TestClientError)
+			},
+			wantApply: true,
+			wantErr:   "failed to apply external-secrets/external-secrets-trusted-ca-bundle configmap resource: test client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			if tt.preReq != nil {
+				tt.preReq(r, mock)
+			}
+			esc := commontest.TestExternalSecretsConfig()
+			if tt.esc != nil {
+				tt.esc(esc)
+			}
+
+			err := r.createOrApplyTrustedCABundleConfigMap(esc, controllerDefaultResourceLabels, false)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("createOrApplyTrustedCABundleConfigMap() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if got := mock.ApplyCallCount() != 0; got != tt.wantApply {
+				t.Errorf("createOrApplyTrustedCABundleConfigMap() apply called: %v, want: %v", got, tt.wantApply)
+			}
+			assertEvent(t, r, tt.wantEvent)
+		})
+	}
+}
+
+func TestCreateOrApplyServiceCABundleConfigMap(t *testing.T) {
+	tests := []struct {
+		name      string
+		preReq    func(*Reconciler, *fakes.FakeCtrlClient)
+		esc       func(*v1alpha1.ExternalSecretsConfig)
+		wantApply bool
+		wantEvent string
+		wantErr   string
+	}{
+		{
+			name: "service-ca not enabled",
+		},
+		{
+			name: "configmap created",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = &v1alpha1.CertProvidersConfig{
+					ServiceCA: &v1alpha1.ServiceCAConfig{Mode: v1alpha1.Enabled},
+				}
+			},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, nil)
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					if got := obj.GetAnnotations()[common.ServiceCAInjectCABundleAnnotation]; got != "true" {
+						t.Errorf("service CA bundle configmap inject-cabundle annotation: %q", got)
+					}
+					return nil
+				})
+			},
+			wantApply: true,
+			wantEvent: "Normal Reconciled configmap resource external-secrets/external-secrets-service-ca-bundle created",
+		},
+		{
+			name: "configmap creation fails",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = &v1alpha1.CertProvidersConfig{
+					ServiceCA: &v1alpha1.ServiceCAConfig{Mode: v1alpha1.Enabled},
+				}
+			},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, nil)
+				m.ApplyReturns(commontest.TestClientError)
+			},
+			wantApply: true,
+			wantErr:   "failed to apply external-secrets/external-secrets-service-ca-bundle configmap resource: test client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			if tt.preReq != nil {
+				tt.preReq(r, mock)
+			}
+			esc := commontest.TestExternalSecretsConfig()
+			if tt.esc != nil {
+				tt.esc(esc)
+			}
+
+			err := r.createOrApplyServiceCABundleConfigMap(esc, controllerDefaultResourceLabels, false)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("createOrApplyServiceCABundleConfigMap() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if got := mock.ApplyCallCount() != 0; got != tt.wantApply {
+				t.Errorf("createOrApplyServiceCABundleConfigMap() apply called: %v, want: %v", got, tt.wantApply)
+			}
+			assertEvent(t, r, tt.wantEvent)
+		})
+	}
+}
+
+func TestUpdateTrustedCABundleConfig(t *testing.T) {
+	caBundle := testCABundle(t)
+
+	tests := []struct {
+		name        string
+		userBundle  map[string]string
+		userErr     error
+		trustedCA   *v1alpha1.TrustedCABundleConfig
+		wantVolumes []corev1.Volume
+		wantCertDir string
+		wantErr     string
+		wantIrrecov bool
+	}{
+		{
+			name: "trusted CA bundle not configured",
+		},
+		{
+			name:      "cluster trusted CA bundle enabled",
+			trustedCA: &v1alpha1.TrustedCABundleConfig{ClusterTrustedCABundle: v1alpha1.Enabled},
+			wantVolumes: []corev1.Volume{
+				testConfigMapVolume(clusterTrustedCABundleVolumeName, testTrustedCABundleConfigMapName, trustedCABundleKey, true),
+			},
+			wantCertDir: "/etc/pki/external-secrets/cluster-trusted-ca:/etc/ssl/certs:/etc/pki/tls/certs",
+		},
+		{
+			name: "cluster and user trusted CA bundles configured",
+			trustedCA: &v1alpha1.TrustedCABundleConfig{
+				ClusterTrustedCABundle: v1alpha1.Enabled,
+				ConfigMapRef:           &v1alpha1.ConfigMapKeyReference{Name: testUserCABundleConfigMapName, Key: "vault.pem"},
+			},
+			userBundle: map[string]string{"vault.pem": caBundle},
+			wantVolumes: []corev1.Volume{
+				testConfigMapVolume(clusterTrustedCABundleVolumeName, testTrustedCABundleConfigMapName, trustedCABundleKey, true),
+				testConfigMapVolume(trustedCABundleVolumeName, testUserCABundleConfigMapName, "vault.pem", false),
+			},
+			wantCertDir: "/etc/pki/external-secrets/cluster-trusted-ca:/etc/pki/external-secrets/trusted-ca:/etc/ssl/certs:/etc/pki/tls/certs",
+		},
+		{
+			name: "user trusted CA bundle configmap does not exist",
+			trustedCA: &v1alpha1.TrustedCABundleConfig{
+				ConfigMapRef: &v1alpha1.ConfigMapKeyReference{Name: testUserCABundleConfigMapName},
+			},
+			wantErr: "failed to fetch external-secrets/vault-ca trusted CA bundle configmap: configmap does not exist",
+		},
+		{
+			name: "user trusted CA bundle key does not exist",
+			trustedCA: &v1alpha1.TrustedCABundleConfig{
+				ConfigMapRef: &v1alpha1.ConfigMapKeyReference{Name: testUserCABundleConfigMapName},
+			},
+			userBundle: map[string]string{"vault.pem": caBundle},
+			wantErr:    `failed to read CA certificates from external-secrets/vault-ca configmap: "ca-bundle.crt" key does not exist`,
+		},
+		{
+			name: "user trusted CA bundle has invalid content",
+			trustedCA: &v1alpha1.TrustedCABundleConfig{
+				ConfigMapRef: &v1alpha1.ConfigMapKeyReference{Name: testUserCABundleConfigMapName},
+			},
+			userBundle:  map[string]string{trustedCABundleKey: "not a certificate"},
+			wantErr:     "invalid CA certificates in external-secrets/vault-ca configmap: no PEM encoded certificates found",
+			wantIrrecov: true,
+		},
+		{
+			name: "user trusted CA bundle fetch fails",
+			trustedCA: &v1alpha1.TrustedCABundleConfig{
+				ConfigMapRef: &v1alpha1.ConfigMapKeyReference{Name: testUserCABundleConfigMapName},
+			},
+			userErr: commontest.TestClientError,
+			wantErr: "failed to fetch external-secrets/vault-ca trusted CA bundle configmap: test client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+				o, ok := obj.(*corev1.ConfigMap)
+				if !ok || ns.Name != testTrustedCABundleConfigMapName {
+					return false, nil
+				}
+				o.Data = map[string]string{trustedCABundleKey: caBundle}
+				return true, nil
+			})
+			r.CtrlClient = mock
+			userCache := &stubCache{getErr: tt.userErr}
+			if tt.userBundle != nil {
+				userCache.configMap = &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: testUserCABundleConfigMapName, Namespace: "external-secrets"},
+					Data:       tt.userBundle,
+				}
+			}
+			r.trustedCABundleCache, r.trustedCABundleNamespace = userCache, "external-secrets"
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ApplicationConfig.TrustedCABundle = tt.trustedCA
+
+			deployment := testDeployment(controllerDeploymentAssetName)
+			err := r.updateTrustedCABundleConfig(deployment, esc)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("updateTrustedCABundleConfig() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if tt.wantIrrecov != common.IsIrrecoverableError(err) {
+				t.Errorf("updateTrustedCABundleConfig() irrecoverable: %v, want: %v", common.IsIrrecoverableError(err), tt.wantIrrecov)
+			}
+			if tt.wantErr != "" {
+				return
+			}
+
+			podSpec := deployment.Spec.Template.Spec
+			if !reflect.DeepEqual(podSpec.Volumes, tt.wantVolumes) {
+				t.Errorf("updateTrustedCABundleConfig() volumes: %v, want: %v", podSpec.Volumes, tt.wantVolumes)
+			}
+			var certDir string
+			for _, e := range podSpec.Containers[0].Env {
+				if e.Name == sslCertDirEnvVarName {
+					certDir = e.Value
+				}
+			}
+			if certDir != tt.wantCertDir {
+				t.Errorf("updateTrustedCABundleConfig() %s: %q, want: %q", sslCertDirEnvVarName, certDir, tt.wantCertDir)
+			}
+			if _, ok := deployment.Spec.Template.Annotations[trustedCABundleHashAnnotation]; ok != (tt.trustedCA != nil) {
+				t.Errorf("updateTrustedCABundleConfig() hash annotation present: %v, want: %v", ok, tt.trustedCA != nil)
+			}
+		})
+	}
+}
+
+func TestTrustedCABundleChangeTriggersRollout(t *testing.T) {
+	bundle := testCABundle(t)
+	r := testReconciler(t)
+	mock := &fakes.FakeCtrlClient{}
+	mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+		obj.(*corev1.ConfigMap).Data = map[string]string{trustedCABundleKey: bundle}
+		return true, nil
+	})
+	r.CtrlClient = mock
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ApplicationConfig.TrustedCABundle = &v1alpha1.TrustedCABundleConfig{ClusterTrustedCABundle: v1alpha1.Enabled}
+
+	fetched := testDeployment(controllerDeploymentAssetName)
+	if err := r.updateTrustedCABundleConfig(fetched, esc); err != nil {
+		t.Fatalf("updateTrustedCABundleConfig() unexpected err: %v", err)
+	}
+
+	bundle = testCABundle(t)
+	desired := testDeployment(controllerDeploymentAssetName)
+	if err := r.updateTrustedCABundleConfig(desired, esc); err != nil {
+		t.Fatalf("updateTrustedCABundleConfig() unexpected err: %v", err)
+	}
+	if desired.Spec.Template.Annotations[trustedCABundleHashAnnotation] == fetched.Spec.Template.Annotations[trustedCABundleHashAnnotation] {
+		t.Errorf("updateTrustedCABundleConfig() pod template hash annotation not updated when trusted CA bundle content changed")
+	}
+}
+
+func testConfigMapVolume(volumeName, configMapName, key string, optional bool) corev1.Volume {
+	return corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+				Items:                []corev1.KeyToPath{{Key: key, Path: trustedCABundleKey}},
+				Optional:             ptr.To(optional),
+			},
+		},
+	}
+}
+
+// stubCache is the dedicated cache of a watch, which runs till stopped, and serves the configMap.
+type stubCache struct {
+	cache.Cache
+	opts      cache.Options
+	stopped   chan struct{}
+	informer  *stubInformer
+	configMap *corev1.ConfigMap
+	getErr    error
+}
+
+func (c *stubCache) Start(ctx context.Context) error {
+	<-ctx.Done()
+	close(c.stopped)
+	return nil
+}
+
+func (c *stubCache) GetInformer(_ context.Context, _ client.Object, _ ...cache.InformerGetOption) (cache.Informer, error) {
+	c.informer = &stubInformer{}
+	return c.informer, nil
+}
+
+func (c *stubCache) Get(_ context.Context, key client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+	if c.getErr != nil {
+		return c.getErr
+	}
+	if c.configMap == nil || client.ObjectKeyFromObject(c.configMap) != key {
+		return errors.NewNotFound(corev1.Resource("configmaps"), key.Name)
+	}
+	c.configMap.DeepCopyInto(obj.(*corev1.ConfigMap))
+	return nil
+}
+
+// stubInformer records the event handler added to the informer.
+type stubInformer struct {
+	cache.Informer
+	handler toolscache.ResourceEventHandler
+}
+
+func (i *stubInformer) AddEventHandler(handler toolscache.ResourceEventHandler) (toolscache.ResourceEventHandlerRegistration, error) {
+	i.handler = handler
+	return nil, nil
+}
+
+func TestSyncTrustedCABundleWatch(t *testing.T) {
+	r := testReconciler(t)
+	r.trustedCABundleEvents = make(chan event.GenericEvent, 1)
+	var caches []*stubCache
+	r.newCache = func(opts cache.Options) (cache.Cache, error) {
+		c := &stubCache{opts: opts, stopped: make(chan struct{})}
+		caches = append(caches, c)
+		return c, nil
+	}
+
+	steps := []struct {
+		name          string
+		namespace     string
+		configMapName string
+		wantCaches    int
+		// wantStopped is the index of the cache expected to be stopped, -1 when none.
+		wantStopped int
+	}{
+		{
+			name:        "configMapRef not configured",
+			wantStopped: -1,
+		},
+		{
+			name:          "configMapRef configured",
+			configMapName: testUserCABundleConfigMapName,
+			wantCaches:    1,
+			wantStopped:   -1,
+		},
+		{
+			name:          "configMapRef unchanged",
+			configMapName: testUserCABundleConfigMapName,
+			wantCaches:    1,
+			wantStopped:   -1,
+		},
+		{
+			name:          "configMapRef changed",
+			configMapName: "another-ca",
+			wantCaches:    1,
+			wantStopped:   -1,
+		},
+		{
+			name:          "operand namespace changed",
+			namespace:     "custom-namespace",
+			configMapName: "another-ca",
+			wantCaches:    2,
+			wantStopped:   0,
+		},
+		{
+			name:        "configMapRef removed",
+			wantCaches:  2,
+			wantStopped: 1,
+		},
+	}
+
+	for _, step := range steps {
+		esc := commontest.TestExternalSecretsConfig()
+		if step.namespace != "" {
+			esc.Spec.ApplicationConfig.Namespace = step.namespace
+		}
+		if step.configMapName != "" {
+			esc.Spec.ApplicationConfig.TrustedCABundle = &v1alpha1.TrustedCABundleConfig{
+				ConfigMapRef: &v1alpha1.ConfigMapKeyReference{Name: step.configMapName},
+			}
+		}
+
+		if err := r.syncTrustedCABundleWatch(esc); err != nil {
+			t.Fatalf("%s: syncTrustedCABundleWatch() unexpected error: %v", step.name, err)
+		}
+		if len(caches) != step.wantCaches {
+			t.Errorf("%s: syncTrustedCABundleWatch() caches created: %d, want: %d", step.name, len(caches), step.wantCaches)
+		}
+		if step.wantStopped >= 0 {
+			select {
+			case <-caches[step.wantStopped].stopped:
+			case <-time.After(time.Second):
+				t.Errorf("%s: syncTrustedCABundleWatch() cache %d not stopped", step.name, step.wantStopped)
+			}
+		}
+		if step.configMapName == "" {
+			if r.trustedCABundleCache != nil {
+				t.Errorf("%s: syncTrustedCABundleWatch() cache retained after configMapRef removed", step.name)
+			}
+			continue
+		}
+
+		current := caches[len(caches)-1]
+		if r.trustedCABundleCache != current || r.trustedCABundleNamespace != getNamespace(esc) {
+			t.Errorf("%s: syncTrustedCABundleWatch() cache of %s namespace not in use", step.name, getNamespace(esc))
+		}
+		var byObject cache.ByObject
+		for obj, b := range current.opts.ByObject {
+			if _, ok := obj.(*corev1.ConfigMap); ok {
+				byObject = b
+			}
+		}
+		if _, ok := byObject.Namespaces[getNamespace(esc)]; !ok || len(byObject.Namespaces) != 1 {
+			t.Errorf("%s: syncTrustedCABundleWatch() cache namespaces: %v, want: [%s]", step.name, byObject.Namespaces, getNamespace(esc))
+		}
+
+		// only the events of the configured ConfigMap are sent to the source.
+		for _, name := range []string{"other-configmap", step.configMapName} {
+			current.informer.handler.OnAdd(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: getNamespace(esc)}}, false)
+		}
+		select {
+		case e := <-r.trustedCABundleEvents:
+			if e.Object.GetName() != step.configMapName {
+				t.Errorf("%s: syncTrustedCABundleWatch() event of %s configmap, want: %s", step.name, e.Object.GetName(), step.configMapName)
+			}
+		default:
+			t.Errorf("%s: syncTrustedCABundleWatch() event of %s configmap not sent", step.name, step.configMapName)
+		}
+		if len(r.trustedCABundleEvents) != 0 {
+			t.Errorf("%s: syncTrustedCABundleWatch() event of other configmap sent", step.name)
+		}
+	}
+}
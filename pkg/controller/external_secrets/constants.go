@@ -3,6 +3,7 @@ package external_secrets
 import (
 	"fmt"
 	"os"
+	"time"
 
 	certmanagerapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -23,12 +24,39 @@ const (
 	// successful reconciliation by the controller.
 	controllerProcessedAnnotation = "operator.openshift.io/external-secrets-processed"
 
+	// driftCorrectionDisabledAnnotation is the annotation for opting a resource created for the operand
+	// out of drift correction, for the modifications made by other actors to be retained.
+	driftCorrectionDisabledAnnotation = "operator.openshift.io/external-secrets-unmanaged"
+
 	// certificateCRDGroupVersion is the group and version of the Certificate CRD provided by cert-manager project.
 	certificateCRDGroupVersion = "cert-manager.io/v1"
 
 	// certificateCRDName is the name of the Certificate CRD provided by cert-manager project.
 	certificateCRDName = "certificates"
 
+	// certificateCRDObjectName is the name of the Certificate CustomResourceDefinition object, which is
+	// watched for detecting cert-manager being installed or removed while the operator is running.
+	certificateCRDObjectName = "certificates.cert-manager.io"
+
+	// monitoringCRDGroupVersion is the group and version of the CRDs provided by the prometheus-operator project.
+	monitoringCRDGroupVersion = "monitoring.coreos.com/v1"
+
+	// serviceMonitorCRDObjectName and prometheusRuleCRDObjectName are the names of the ServiceMonitor and
+	// PrometheusRule CustomResourceDefinition objects, which are watched for detecting the availability of
+	// the prometheus-operator while the operator is running.
+	serviceMonitorCRDObjectName = "servicemonitors.monitoring.coreos.com"
+	prometheusRuleCRDObjectName = "prometheusrules.monitoring.coreos.com"
+
+	// clusterProxyCRDGroupVersion is the group and version of the OpenShift cluster-wide Proxy CRD.
+	clusterProxyCRDGroupVersion = "config.openshift.io/v1"
+
+	// clusterProxyCRDName is the name of the OpenShift cluster-wide Proxy CRD.
+	clusterProxyCRDName = "proxies"
+
+	// clusterProxyObjectName is the name of the OpenShift cluster-wide Proxy object, which
+	// holds the proxy configuration used as fallback for the operand containers.
+	clusterProxyObjectName = "cluster"
+
 	// externalsecretsImageVersionEnvVarName is the environment variable key name
 	// containing the image version of the external-secrets operand as value.
 	externalsecretsImageVersionEnvVarName = "OPERAND_EXTERNAL_SECRETS_IMAGE_VERSION"
@@ -45,18 +73,77 @@ const (
 	// containing the image version of the bitwarden-sdk-server as value.
 	bitwardenImageVersionEnvVarName = "BITWARDEN_SDK_SERVER_IMAGE_VERSION"
 
-	// externalsecretsDefaultNamespace is the namespace where the `external-secrets` operand required resources
-	// will be created, when ExternalSecretsConfig.Spec.Namespace is not set.
-	externalsecretsDefaultNamespace = "external-secrets"
+	// certControllerTLSSecretWebhook is the TLS secret populated by the inbuilt cert-controller component for the
+	// webhook component, which is created by the operator.
+	certControllerTLSSecretWebhook = "external-secrets-webhook"
 
 	// certmanagerTLSSecretWebhook is the TLS secret created by cert-manager for the webhook component. A different
 	// name is used to avoiding clash with the secret created by the inbuilt cert-controller component.
 	certmanagerTLSSecretWebhook = "external-secrets-webhook-cm"
+
+	// serviceCATLSSecretWebhook is the TLS secret generated by the OpenShift service-ca operator for the webhook
+	// component. A different name is used, as service-ca does not take over an existing secret of the same name.
+	serviceCATLSSecretWebhook = "external-secrets-webhook-service-ca"
+
+	// serviceCATLSSecretBitwarden is the TLS secret generated by the OpenShift service-ca operator for the
+	// bitwarden-sdk-server component.
+	serviceCATLSSecretBitwarden = "bitwarden-tls-certs-service-ca"
+
+	// defaultCertificateExpiryWarningWindow is the period before the expiry of the user provided certificates,
+	// from when the certificates are reported as expiring, when not configured in ExternalSecretsConfig.
+	defaultCertificateExpiryWarningWindow = 30 * 24 * time.Hour
+
+	// userCertificateCheckInterval is the interval at which the user provided certificate secrets, which are
+	// not watched, are checked by the operator for renewal and expiry. It is unrelated to the webhook
+	// certificateCheckInterval, which is the interval of the certificate checks by the operand.
+	userCertificateCheckInterval = 5 * time.Minute
+
+	// caCertificateKey is the key name in the user provided TLS secrets holding the CA certificate.
+	caCertificateKey = "ca.crt"
+
+	// serviceCABundleKey is the key name in the ConfigMap into which the OpenShift service CA bundle is injected.
+	serviceCABundleKey = "service-ca.crt"
+
+	// trustedCABundleKey is the key name in the ConfigMap holding the PEM encoded CA certificates,
+	// into which the OpenShift cluster-wide trusted CA bundle is injected.
+	trustedCABundleKey = "ca-bundle.crt"
+
+	// trustedCABundleHashAnnotation is the pod template annotation holding the hash of the trusted
+	// CA bundle content, for the operand pods to be rolled out when the bundle changes.
+	trustedCABundleHashAnnotation = "operator.openshift.io/trusted-ca-bundle-hash"
+
+	// clusterTrustedCABundleVolumeName is the name of the volume with the OpenShift cluster-wide trusted CA bundle.
+	clusterTrustedCABundleVolumeName = "cluster-trusted-ca-bundle"
+
+	// clusterTrustedCABundleMountPath is the directory in which the OpenShift cluster-wide trusted CA bundle
+	// is mounted in the operand containers.
+	clusterTrustedCABundleMountPath = "/etc/pki/external-secrets/cluster-trusted-ca"
+
+	// trustedCABundleVolumeName is the name of the volume with the user provided trusted CA bundle.
+	trustedCABundleVolumeName = "trusted-ca-bundle"
+
+	// trustedCABundleMountPath is the directory in which the user provided trusted CA bundle is mounted
+	// in the operand containers.
+	trustedCABundleMountPath = "/etc/pki/external-secrets/trusted-ca"
+
+	// sslCertDirEnvVarName is the environment variable used by the operand containers for reading
+	// the CA certificates from the listed directories.
+	sslCertDirEnvVarName = "SSL_CERT_DIR"
 )
 
 var (
 	// certificateCRDGKV is the group.version/kind of the Certificate CRD.
 	certificateCRDGKV = fmt.Sprintf("certificate.%s/%s", certmanagerv1.SchemeGroupVersion.Group, certmanagerv1.SchemeGroupVersion.Version)
+
+	// monitoringCRDGKV is the group/version of the ServiceMonitor and PrometheusRule CRDs.
+	monitoringCRDGKV = monitoringCRDGroupVersion
+
+	// clusterProxyCRDGKV is the group.version/kind of the OpenShift cluster-wide Proxy CRD.
+	clusterProxyCRDGKV = fmt.Sprintf("proxy.%s", clusterProxyCRDGroupVersion)
+
+	// systemCertDirs is the list of directories containing the system default CA certificates,
+	// which must be retained when SSL_CERT_DIR is overridden in the operand containers.
+	systemCertDirs = []string{"/etc/ssl/certs", "/etc/pki/tls/certs"}
 )
 
 var (
@@ -74,6 +161,8 @@ var (
 // and made available by the pkg/operator/assets package.
 const (
 	externalsecretsNamespaceAssetName             = "external-secrets/external-secrets-namespace.yaml"
+	trustedCABundleConfigMapAssetName             = "external-secrets/configmap_trusted-ca-bundle.yaml"
+	serviceCABundleConfigMapAssetName             = "external-secrets/configmap_service-ca-bundle.yaml"
 	bitwardenCertificateAssetName                 = "external-secrets/certificate_bitwarden-tls-certs.yml"
 	webhookCertificateAssetName                   = "external-secrets/resources/certificate_external-secrets-webhook.yml"
 	certControllerClusterRoleAssetName            = "external-secrets/resources/clusterrole_external-secrets-cert-controller.yml"
@@ -106,6 +195,14 @@ const (
 	allowCertControllerTrafficAssetName           = "external-secrets/networkpolicy_allow-api-server-egress-for-cert-controller-traffic.yaml"
 	allowBitwardenServerTrafficAssetName          = "external-secrets/networkpolicy_allow-api-server-egress-for-bitwarden-sever.yaml"
 	allowDnsTrafficAsserName                      = "external-secrets/networkpolicy_allow-dns.yaml"
+	controllerPodDisruptionBudgetAssetName        = "external-secrets/poddisruptionbudget_external-secrets.yaml"
+	webhookPodDisruptionBudgetAssetName           = "external-secrets/poddisruptionbudget_external-secrets-webhook.yaml"
+	metricsServiceMonitorAssetName                = "external-secrets/servicemonitor_external-secrets-metrics.yaml"
+	certControllerMetricsServiceMonitorAssetName  = "external-secrets/servicemonitor_external-secrets-cert-controller-metrics.yaml"
+	prometheusRuleAssetName                       = "external-secrets/prometheusrule_external-secrets.yaml"
+	allowClusterMonitoringTrafficAssetName        = "external-secrets/networkpolicy_allow-metrics-ingress-from-cluster-monitoring.yaml"
+	prometheusRoleAssetName                       = "external-secrets/role_prometheus-k8s.yaml"
+	prometheusRoleBindingAssetName                = "external-secrets/rolebinding_prometheus-k8s.yaml"
 )
 
 var (
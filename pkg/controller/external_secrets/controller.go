@@ -20,15 +20,20 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"sync/atomic"
 
 	webhook "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	crdv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/selection"
@@ -41,10 +46,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"github.com/go-logr/logr"
 
@@ -69,8 +77,11 @@ var (
 	controllerManagedResources = []client.Object{
 		&rbacv1.ClusterRole{},
 		&rbacv1.ClusterRoleBinding{},
+		&corev1.ConfigMap{},
 		&appsv1.Deployment{},
+		&corev1.Namespace{},
 		&networkingv1.NetworkPolicy{},
+		&policyv1.PodDisruptionBudget{},
 		&rbacv1.Role{},
 		&rbacv1.RoleBinding{},
 		&corev1.Secret{},
@@ -90,6 +101,36 @@ type Reconciler struct {
 	log                   logr.Logger
 	esm                   *operatorv1alpha1.ExternalSecretsManager
 	optionalResourcesList map[string]struct{}
+	// inventory is the set of resources reconciled for the current configuration,
+	// which is used for pruning the resources no longer required.
+	inventory resourceInventory
+	// driftCorrectionSkipped is the list of the modified resources not reconciled in the current
+	// reconciliation, as annotated for opting out of drift correction.
+	driftCorrectionSkipped []string
+	// appliedConfigOverrides is the index of the unsupportedConfigOverrides applied in the current
+	// reconciliation, mapped to the resource patched.
+	appliedConfigOverrides map[int]string
+	// appliedResources is the state of the resources as last applied, mapped to the inventory key,
+	// which is used for skipping the apply of the resources whose desired state is unchanged.
+	appliedResources map[string]appliedResource
+	// controller and cache are used for adding the watches on the optional
+	// resources, when the CRDs are installed after the controller is started.
+	controller controller.Controller
+	cache      cache.Cache
+	// issuerWatchNamespace is the namespace of the Issuer resources being watched, and is
+	// empty when not watched.
+	issuerWatchNamespace string
+	// newCache creates the dedicated caches for watching the resources not created by the controller,
+	// like the user provided trusted CA bundle ConfigMap.
+	newCache func(cache.Options) (cache.Cache, error)
+	// trustedCABundleEvents is the source of the events of the user provided trusted CA bundle ConfigMap,
+	// named trustedCABundleName, which are sent by the handler of trustedCABundleCache. The cache has the
+	// ConfigMaps of trustedCABundleNamespace, and is stopped with stopTrustedCABundleCache.
+	trustedCABundleEvents    chan event.GenericEvent
+	trustedCABundleName      atomic.Value
+	trustedCABundleCache     cache.Cache
+	trustedCABundleNamespace string
+	stopTrustedCABundleCache context.CancelFunc
 }
 
 // +kubebuilder:rbac:groups=operator.openshift.io,resources=externalsecretsconfigs,verbs=get;list;watch;create;update
@@ -99,14 +140,20 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;watch;create;update;patch
 
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles;rolebindings;clusterroles;clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=admissionregistration.k8s.io,resources=validatingwebhookconfigurations,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=events;secrets;services;serviceaccounts,verbs=get;list;watch;create;update;delete;patch
-// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update
-// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates;clusterissuers;issuers,verbs=get;list;watch;create;update
-// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update
-// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=certificates,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=cert-manager.io,resources=clusterissuers;issuers,verbs=get;list;watch;create;update
+// +kubebuilder:rbac:groups=networking.k8s.io,resources=networkpolicies,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=policy,resources=poddisruptionbudgets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;update;patch
+// +kubebuilder:rbac:groups=config.openshift.io,resources=proxies,verbs=get;list;watch
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors;prometheusrules,verbs=get;list;watch;create;update;patch;delete
 
 // +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+// +kubebuilder:rbac:groups=discovery.k8s.io,resources=endpointslices,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=apiextensions.k8s.io,resources=customresourcedefinitions,verbs=get;list;watch;create;update;patch;delete
@@ -134,6 +181,20 @@ func New(ctx context.Context, mgr ctrl.Manager) (*Reconciler, error) {
 	}
 	r.log.V(1).Info("Cert-manager check complete", "installed", certManagerInstalled)
 
+	// Check if prometheus-operator is installed and register ServiceMonitor and PrometheusRule informers if present
+	monitoringInstalled, err := checkAndRegisterMonitoring(mgr, r)
+	if err != nil {
+		return nil, err
+	}
+	r.log.V(1).Info("Monitoring check complete", "installed", monitoringInstalled)
+
+	// Check if OpenShift cluster-wide Proxy is available and register informer if present
+	clusterProxyInstalled, err := checkAndRegisterClusterProxy(mgr, r)
+	if err != nil {
+		return nil, err
+	}
+	r.log.V(1).Info("Cluster proxy check complete", "installed", clusterProxyInstalled)
+
 	// Use the manager's client - it reads from the manager's cache
 	// which is configured with label selectors via NewCacheBuilder()
 	c, err := NewClient(mgr, r)
@@ -178,38 +239,41 @@ func NewUncachedClient(m manager.Manager) (operatorclient.CtrlClient, error) {
 // NewCacheBuilder returns a cache builder function that configures the manager's cache
 // with label selectors for managed resources. This eliminates the need for a separate custom cache.
 func NewCacheBuilder(config *rest.Config) cache.NewCacheFunc {
-	// Check if cert-manager CRD exists BEFORE creating the cache
-	// This prevents cache creation failure if we try to watch a non-existent CRD
-	certManagerExists, err := isCRDInstalled(config, certificateCRDName, certificateCRDGroupVersion)
+	clusterProxyExists, err := isCRDInstalled(config, clusterProxyCRDName, clusterProxyCRDGroupVersion)
 	if err != nil {
-		ctrl.Log.V(1).WithName("cache-setup").Error(err, "Failed to check cert-manager CRD, assuming not installed")
-		certManagerExists = false
+		ctrl.Log.V(1).WithName("cache-setup").Error(err, "Failed to check cluster proxy CRD, assuming not installed")
+		clusterProxyExists = false
 	}
 
 	return func(config *rest.Config, opts cache.Options) (cache.Cache, error) {
 		// Build the object list with label selectors
-		objectList := buildCacheObjectList(certManagerExists)
+		objectList := buildCacheObjectList(clusterProxyExists)
 
 		// Configure cache options with our label-filtered resources
-		opts.ByObject = objectList
+		mgrOpts := opts
+		mgrOpts.ByObject = objectList
+
+		// Create the cache using the standard cache constructor
+		c, err := cache.New(config, mgrOpts)
+		if err != nil {
+			return nil, err
+		}
 
-		// Create and return the cache using the standard cache constructor
-		return cache.New(config, opts)
+		// Objects of the CRDs which could be installed at any time are served from
+		// their own cache, created only when the CRD is present.
+		return newOptionalResourcesCache(c, config, opts, buildOptionalCacheObjectList())
 	}
 }
 
 // buildCacheObjectList creates the cache configuration with label selectors
 // for managed resources.
-func buildCacheObjectList(includeCertManager bool) map[client.Object]cache.ByObject {
-	managedResourceLabelReq, _ := labels.NewRequirement(requestEnqueueLabelKey, selection.Equals, []string{requestEnqueueLabelValue})
-	managedResourceLabelReqSelector := labels.NewSelector().Add(*managedResourceLabelReq)
-
+func buildCacheObjectList(includeClusterProxy bool) map[client.Object]cache.ByObject {
 	objectList := make(map[client.Object]cache.ByObject)
 
 	// Resources created by the controller - filter by app=external-secrets label
 	for _, res := range controllerManagedResources {
 		objectList[res] = cache.ByObject{
-			Label: managedResourceLabelReqSelector,
+			Label: managedResourceLabelSelector(),
 		}
 	}
 
@@ -217,16 +281,61 @@ func buildCacheObjectList(includeCertManager bool) map[client.Object]cache.ByObj
 	objectList[&operatorv1alpha1.ExternalSecretsConfig{}] = cache.ByObject{}
 	objectList[&operatorv1alpha1.ExternalSecretsManager{}] = cache.ByObject{}
 
-	// Certificate objects - only include if cert-manager CRD exists
-	if includeCertManager {
-		objectList[&certmanagerv1.Certificate{}] = cache.ByObject{
-			Label: managedResourceLabelReqSelector,
+	// cert-manager Certificate and prometheus-operator CRDs - watched for detecting the
+	// installation and removal of the optional CRDs. Field selectors cannot match more than
+	// a single name, hence all the CRDs are cached, without the spec holding the schema.
+	objectList[&crdv1.CustomResourceDefinition{}] = cache.ByObject{
+		Transform: stripCRDSpec,
+	}
+
+	// Cluster-wide Proxy object - only include if the CRD exists, and is limited
+	// to the single object holding the cluster proxy configuration.
+	if includeClusterProxy {
+		objectList[newClusterProxyObject()] = cache.ByObject{
+			Field: fields.OneTermEqualSelector("metadata.name", clusterProxyObjectName),
 		}
 	}
 
 	return objectList
 }
 
+// stripCRDSpec drops the spec and the managed fields of the cached CRDs, which are not required for
+// detecting the availability of the optional CRDs.
+func stripCRDSpec(obj any) (any, error) {
+	if crd, ok := obj.(*crdv1.CustomResourceDefinition); ok {
+		crd.Spec = crdv1.CustomResourceDefinitionSpec{}
+		crd.SetManagedFields(nil)
+	}
+	return obj, nil
+}
+
+// buildOptionalCacheObjectList creates the cache configuration with label selectors for
+// the managed resources of the CRDs, which need not be installed when the operator starts.
+func buildOptionalCacheObjectList() map[client.Object]cache.ByObject {
+	return map[client.Object]cache.ByObject{
+		&certmanagerv1.Certificate{}: {
+			Label: managedResourceLabelSelector(),
+		},
+		// the issuers are not created by the controller, and are watched for the readiness of
+		// the one referenced in the configuration. The Issuer cache is restricted to the operand
+		// namespace, which is known only on reconciling the configuration, see syncIssuerWatch.
+		&certmanagerv1.Issuer{}:        {},
+		&certmanagerv1.ClusterIssuer{}: {},
+		newServiceMonitorObject(): {
+			Label: managedResourceLabelSelector(),
+		},
+		newPrometheusRuleObject(): {
+			Label: managedResourceLabelSelector(),
+		},
+	}
+}
+
+// managedResourceLabelSelector returns the label selector for filtering the resources created by the controller.
+func managedResourceLabelSelector() labels.Selector {
+	managedResourceLabelReq, _ := labels.NewRequirement(requestEnqueueLabelKey, selection.Equals, []string{requestEnqueueLabelValue})
+	return labels.NewSelector().Add(*managedResourceLabelReq)
+}
+
 // checkAndRegisterCertificates checks if cert-manager CRD exists and registers Certificate informer if present.
 // Returns true if Certificate CRD is installed.
 func checkAndRegisterCertificates(mgr ctrl.Manager, r *Reconciler) (bool, error) {
@@ -238,45 +347,69 @@ func checkAndRegisterCertificates(mgr ctrl.Manager, r *Reconciler) (bool, error)
 	if exist {
 		r.optionalResourcesList[certificateCRDGKV] = struct{}{}
 
-		// Get informer for Certificate - this registers it with the manager's cache
-		_, err = mgr.GetCache().GetInformer(context.Background(), &certmanagerv1.Certificate{})
-		if err != nil {
-			return false, fmt.Errorf("failed to add Certificate informer: %w", err)
+		// Get informers for Certificate and ClusterIssuer - this registers them with the manager's cache
+		for _, obj := range certManagerWatchedObjects() {
+			if _, err = mgr.GetCache().GetInformer(context.Background(), obj); err != nil {
+				return false, fmt.Errorf("failed to add %T informer: %w", obj, err)
+			}
 		}
 
-		ctrl.Log.V(1).WithName("cache-setup").Info("Registered Certificate resource with manager cache")
+		ctrl.Log.V(1).WithName("cache-setup").Info("Registered Certificate and ClusterIssuer resources with manager cache")
 	}
 
 	return exist, nil
 }
 
-// SetupWithManager is for creating a controller instance with predicates and event filters.
-func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
-	mapFunc := func(ctx context.Context, obj client.Object) []reconcile.Request {
-		r.log.V(4).Info("received reconcile event", "object", fmt.Sprintf("%T", obj), "name", obj.GetName(), "namespace", obj.GetNamespace())
-
-		objLabels := obj.GetLabels()
-		if objLabels != nil {
-			if objLabels[requestEnqueueLabelKey] == requestEnqueueLabelValue {
-				return []reconcile.Request{
-					{
-						NamespacedName: types.NamespacedName{
-							Name: common.ExternalSecretsConfigObjectName,
-						},
-					},
-				}
-			}
+// checkAndRegisterMonitoring checks if prometheus-operator ServiceMonitor and PrometheusRule CRDs exist and
+// registers their informers if present. Returns true if both the CRDs are installed.
+func checkAndRegisterMonitoring(mgr ctrl.Manager, r *Reconciler) (bool, error) {
+	for _, name := range []string{"servicemonitors", "prometheusrules"} {
+		exist, err := isCRDInstalled(mgr.GetConfig(), name, monitoringCRDGroupVersion)
+		if err != nil {
+			return false, fmt.Errorf("failed to check %s/%s CRD is installed: %w", monitoringCRDGroupVersion, name, err)
+		}
+		if !exist {
+			return false, nil
 		}
-		r.log.V(4).Info("object not of interest, ignoring reconcile event", "object", fmt.Sprintf("%T", obj), "name", obj.GetName(), "namespace", obj.GetNamespace())
-		return []reconcile.Request{}
 	}
 
-	// predicate function to ignore events for objects not managed by controller.
-	managedResources := predicate.NewPredicateFuncs(func(object client.Object) bool {
-		return object.GetLabels() != nil && object.GetLabels()[requestEnqueueLabelKey] == requestEnqueueLabelValue
-	})
+	r.optionalResourcesList[monitoringCRDGKV] = struct{}{}
+	for _, obj := range []client.Object{newServiceMonitorObject(), newPrometheusRuleObject()} {
+		if _, err := mgr.GetCache().GetInformer(context.Background(), obj); err != nil {
+			return false, fmt.Errorf("failed to add %s informer: %w", obj.GetObjectKind().GroupVersionKind().Kind, err)
+		}
+	}
+	ctrl.Log.V(1).WithName("cache-setup").Info("Registered ServiceMonitor and PrometheusRule resources with manager cache")
+
+	return true, nil
+}
+
+// checkAndRegisterClusterProxy checks if OpenShift cluster-wide Proxy CRD exists and registers Proxy informer if present.
+// Returns true if Proxy CRD is installed.
+func checkAndRegisterClusterProxy(mgr ctrl.Manager, r *Reconciler) (bool, error) {
+	exist, err := isCRDInstalled(mgr.GetConfig(), clusterProxyCRDName, clusterProxyCRDGroupVersion)
+	if err != nil {
+		return false, fmt.Errorf("failed to check %s/%s CRD is installed: %w", clusterProxyCRDGroupVersion, clusterProxyCRDName, err)
+	}
+
+	if exist {
+		r.optionalResourcesList[clusterProxyCRDGKV] = struct{}{}
+
+		if _, err = mgr.GetCache().GetInformer(context.Background(), newClusterProxyObject()); err != nil {
+			return false, fmt.Errorf("failed to add cluster Proxy informer: %w", err)
+		}
+
+		ctrl.Log.V(1).WithName("cache-setup").Info("Registered cluster Proxy resource with manager cache")
+	}
+
+	return exist, nil
+}
+
+// SetupWithManager is for creating a controller instance with predicates and event filters.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	withIgnoreStatusUpdatePredicates := builder.WithPredicates(predicate.GenerationChangedPredicate{}, managedResources)
 	managedResourcePredicate := builder.WithPredicates(managedResources)
+	mapFunc := handler.EnqueueRequestsFromMapFunc(r.managedResourceMapFunc)
 
 	mgrBuilder := ctrl.NewControllerManagedBy(mgr).
 		For(&operatorv1alpha1.ExternalSecretsConfig{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
@@ -285,28 +418,111 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	for _, res := range controllerManagedResources {
 		switch res {
 		case &appsv1.Deployment{}:
-			mgrBuilder.Watches(res, handler.EnqueueRequestsFromMapFunc(mapFunc), withIgnoreStatusUpdatePredicates)
+			mgrBuilder.Watches(res, mapFunc, withIgnoreStatusUpdatePredicates)
 		case &corev1.Secret{}:
-			mgrBuilder.WatchesMetadata(res, handler.EnqueueRequestsFromMapFunc(mapFunc), builder.WithPredicates(predicate.LabelChangedPredicate{}))
+			mgrBuilder.WatchesMetadata(res, mapFunc, builder.WithPredicates(predicate.LabelChangedPredicate{}))
 		default:
-			mgrBuilder.Watches(res, handler.EnqueueRequestsFromMapFunc(mapFunc), managedResourcePredicate)
+			mgrBuilder.Watches(res, mapFunc, managedResourcePredicate)
 		}
 	}
 
 	// Watch ExternalSecretsManager
-	mgrBuilder.Watches(&operatorv1alpha1.ExternalSecretsManager{}, handler.EnqueueRequestsFromMapFunc(mapFunc), withIgnoreStatusUpdatePredicates)
+	mgrBuilder.Watches(&operatorv1alpha1.ExternalSecretsManager{}, mapFunc, withIgnoreStatusUpdatePredicates)
+
+	// Conditionally watch Certificate and ClusterIssuer if cert-manager is installed, and when
+	// cert-manager gets installed later, the watches are added on detecting the Certificate CRD.
+	// Issuer is watched only in the operand namespace, once known on reconciling the configuration.
+	if r.IsCertManagerInstalled() {
+		mgrBuilder.Watches(&certmanagerv1.Certificate{}, mapFunc, managedResourcePredicate)
+		mgrBuilder.Watches(&certmanagerv1.ClusterIssuer{}, handler.EnqueueRequestsFromMapFunc(r.issuerMapFunc))
+	}
+
+	// Conditionally watch ServiceMonitor and PrometheusRule if prometheus-operator is installed,
+	// and when installed later, the watches are added on detecting the CRDs.
+	if r.isMonitoringInstalled() {
+		mgrBuilder.Watches(newServiceMonitorObject(), mapFunc, managedResourcePredicate)
+		mgrBuilder.Watches(newPrometheusRuleObject(), mapFunc, managedResourcePredicate)
+	}
+
+	// Watch the cert-manager Certificate and prometheus-operator CRDs for detecting
+	// them being installed or removed while the operator is running.
+	optionalCRDPredicate := predicate.NewPredicateFuncs(func(object client.Object) bool {
+		switch object.GetName() {
+		case certificateCRDObjectName, serviceMonitorCRDObjectName, prometheusRuleCRDObjectName:
+			return true
+		}
+		return false
+	})
+	mgrBuilder.Watches(&crdv1.CustomResourceDefinition{}, handler.EnqueueRequestsFromMapFunc(r.externalSecretsConfigMapFunc), builder.WithPredicates(optionalCRDPredicate))
+
+	// Conditionally watch the cluster-wide Proxy, changes to which must be propagated
+	// to the operand deployments when proxy is not configured in ExternalSecretsConfig or
+	// ExternalSecretsManager.
+	if r.isClusterProxyInstalled() {
+		clusterProxyPredicate := predicate.NewPredicateFuncs(func(object client.Object) bool {
+			return object.GetName() == clusterProxyObjectName
+		})
+		mgrBuilder.Watches(newClusterProxyObject(), handler.EnqueueRequestsFromMapFunc(r.externalSecretsConfigMapFunc), builder.WithPredicates(clusterProxyPredicate))
+	}
 
-	// Conditionally watch Certificate if cert-manager is installed
-	// Note: Certificate is already declared in buildCacheObjectList(), this just sets up the watch
-	if _, ok := r.optionalResourcesList[certificateCRDGKV]; ok {
-		mgrBuilder.Watches(&certmanagerv1.Certificate{}, handler.EnqueueRequestsFromMapFunc(mapFunc), managedResourcePredicate)
+	// Watch the user provided trusted CA bundle ConfigMap, the events of which are sent
+	// from the dedicated cache added by syncTrustedCABundleWatch.
+	r.trustedCABundleEvents = make(chan event.GenericEvent)
+	mgrBuilder.WatchesRawSource(source.Channel(r.trustedCABundleEvents, handler.EnqueueRequestsFromMapFunc(r.externalSecretsConfigMapFunc)))
+
+	c, err := mgrBuilder.Build(r)
+	if err != nil {
+		return err
+	}
+	r.controller = c
+	r.cache = mgr.GetCache()
+	r.newCache = func(opts cache.Options) (cache.Cache, error) {
+		opts.Scheme, opts.Mapper = mgr.GetScheme(), mgr.GetRESTMapper()
+		return cache.New(mgr.GetConfig(), opts)
 	}
 
-	return mgrBuilder.Complete(r)
+	return nil
+}
+
+// managedResources is the predicate function to ignore events for objects not managed by controller.
+var managedResources = predicate.NewPredicateFuncs(func(object client.Object) bool {
+	return object.GetLabels() != nil && object.GetLabels()[requestEnqueueLabelKey] == requestEnqueueLabelValue
+})
+
+// managedResourceMapFunc enqueues the reconcile request for the events of the resources created by the controller.
+func (r *Reconciler) managedResourceMapFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	r.log.V(4).Info("received reconcile event", "object", fmt.Sprintf("%T", obj), "name", obj.GetName(), "namespace", obj.GetNamespace())
+
+	objLabels := obj.GetLabels()
+	if objLabels != nil {
+		if objLabels[requestEnqueueLabelKey] == requestEnqueueLabelValue {
+			return []reconcile.Request{
+				{
+					NamespacedName: types.NamespacedName{
+						Name: common.ExternalSecretsConfigObjectName,
+					},
+				},
+			}
+		}
+	}
+	r.log.V(4).Info("object not of interest, ignoring reconcile event", "object", fmt.Sprintf("%T", obj), "name", obj.GetName(), "namespace", obj.GetNamespace())
+	return []reconcile.Request{}
+}
+
+// externalSecretsConfigMapFunc enqueues the reconcile request for the events of the cluster
+// resources, which are not created by the controller but influence the operand deployment.
+func (r *Reconciler) externalSecretsConfigMapFunc(ctx context.Context, obj client.Object) []reconcile.Request {
+	r.log.V(4).Info("received reconcile event", "object", fmt.Sprintf("%T", obj), "name", obj.GetName())
+	return []reconcile.Request{
+		{
+			NamespacedName: types.NamespacedName{
+				Name: common.ExternalSecretsConfigObjectName,
+			},
+		},
+	}
 }
 
 // isCRDInstalled is for checking whether a CRD with given `group/version` and `name` exists.
-// TODO: Adds watches or polling to dynamically notify when a CRD gets installed.
 func isCRDInstalled(config *rest.Config, name, groupVersion string) (bool, error) {
 	discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
 	if err != nil {
@@ -344,11 +560,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			// requeue (have to wait for a new notification), and can be processed
 			// on deleted requests.
 			r.log.V(1).Info("externalsecretsconfigs.operator.openshift.io object not found, skipping reconciliation", "request", req)
+			resetExternalSecretsConfigMetrics()
 			return ctrl.Result{}, nil
 		}
 		return ctrl.Result{}, fmt.Errorf("failed to fetch externalsecretsconfigs.operator.openshift.io %q during reconciliation: %w", req.NamespacedName, err)
 	}
 
+	if err := r.syncCertManagerInstallation(esc); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to sync cert-manager installation state: %w", err)
+	}
+
+	if err := r.syncMonitoringInstallation(esc); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to sync prometheus-operator installation state: %w", err)
+	}
+
+	if err := r.syncTrustedCABundleWatch(esc); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to sync trusted CA bundle configmap watch: %w", err)
+	}
+
 	if !esc.DeletionTimestamp.IsZero() {
 		r.log.V(1).Info("externalsecretsconfigs.operator.openshift.io is marked for deletion", "name", req.NamespacedName)
 
@@ -357,6 +586,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		} else if requeue {
 			return ctrl.Result{RequeueAfter: common.DefaultRequeueTime}, nil
 		}
+		return ctrl.Result{}, nil
 	}
 
 	// Set finalizers on the externalsecretsconfigs.operator.openshift.io resource
@@ -388,12 +618,53 @@ func (r *Reconciler) processReconcileRequest(esc *operatorv1alpha1.ExternalSecre
 		createRecon = true
 	}
 
-	var errUpdate error = nil
+	// metrics are derived from the status conditions, which are
+	// updated in all the paths below.
+	defer recordExternalSecretsConfigMetrics(esc)
+
+	managementState := getManagementState(esc)
+	if managementState == operatorv1alpha1.Removed {
+		return r.processRemovedRequest(esc, req)
+	}
+
+	var err, errUpdate error
 	observedGeneration := esc.GetGeneration()
-	err := r.reconcileExternalSecretsDeployment(esc, createRecon)
+	prevConditions := slices.Clone(esc.Status.Conditions)
+	prevDesiredStateHash, prevLastAppliedGeneration := esc.Status.DesiredStateHash, esc.Status.LastAppliedGeneration
+	prevCertificateMigration := esc.Status.CertificateMigration.DeepCopy()
+	r.driftCorrectionSkipped = nil
+	r.appliedConfigOverrides = nil
+	if managementState == operatorv1alpha1.Managed {
+		err = r.reconcileExternalSecretsDeployment(esc, createRecon)
+	} else {
+		r.log.V(1).Info("managementState is Unmanaged, skipping reconciliation of external-secrets deployment", "request", req)
+	}
+	var rollout *operandRolloutStatus
+	if err == nil {
+		rollout, err = r.getOperandRolloutStatus(esc)
+	}
+	var certificatesCond, certificateExpiringCond *metav1.Condition
+	if err == nil {
+		certificatesCond, err = r.getCertificatesReadyCondition(esc)
+	}
+	if err == nil {
+		certificateExpiringCond, err = r.getCertificateExpiringCondition(esc)
+	}
+	apimeta.SetStatusCondition(&esc.Status.Conditions, r.getCertManagerInstalledCondition(esc))
+	if pausedCond := r.getReconciliationPausedCondition(esc); pausedCond != nil {
+		if prev := apimeta.FindStatusCondition(esc.Status.Conditions, pausedCond.Type); prev == nil || prev.Message != pausedCond.Message {
+			r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ReconciliationPaused", "%s", pausedCond.Message)
+		}
+		apimeta.SetStatusCondition(&esc.Status.Conditions, *pausedCond)
+	} else if apimeta.RemoveStatusCondition(&esc.Status.Conditions, operatorv1alpha1.ReconciliationPaused) {
+		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "ReconciliationResumed", "external-secrets deployment is reconciled to desired state")
+	}
+	r.setUnsupportedConfigOverridesCondition(esc, managementState == operatorv1alpha1.Managed && err == nil)
 	if err != nil {
 		r.log.Error(err, "failed to reconcile external-secrets deployment", "request", req)
+		recordReconcileError(err)
 		isFatal := common.IsIrrecoverableError(err)
+		cause := string(common.GetErrorCause(err))
 
 		degradedCond := metav1.Condition{
 			Type:               operatorv1alpha1.Degraded,
@@ -410,7 +681,11 @@ func (r *Reconciler) processReconcileRequest(esc *operatorv1alpha1.ExternalSecre
 			degradedCond.Message = fmt.Sprintf("reconciliation failed with irrecoverable error, not retrying: %v", err)
 
 			readyCond.Status = metav1.ConditionFalse
-			readyCond.Reason = operatorv1alpha1.ReasonReady
+			readyCond.Reason = operatorv1alpha1.ReasonFailed
+			if cause != "" {
+				degradedCond.Reason = cause
+				readyCond.Reason = cause
+			}
 		} else {
 			degradedCond.Status = metav1.ConditionFalse
 			degradedCond.Reason = operatorv1alpha1.ReasonReady
@@ -418,10 +693,15 @@ func (r *Reconciler) processReconcileRequest(esc *operatorv1alpha1.ExternalSecre
 			readyCond.Status = metav1.ConditionFalse
 			readyCond.Reason = operatorv1alpha1.ReasonInProgress
 			readyCond.Message = fmt.Sprintf("reconciliation failed, retrying: %v", err)
+			if cause != "" {
+				readyCond.Reason = cause
+			}
 		}
 
-		if apimeta.SetStatusCondition(&esc.Status.Conditions, degradedCond) ||
-			apimeta.SetStatusCondition(&esc.Status.Conditions, readyCond) {
+		apimeta.SetStatusCondition(&esc.Status.Conditions, degradedCond)
+		apimeta.SetStatusCondition(&esc.Status.Conditions, readyCond)
+		if !reflect.DeepEqual(prevConditions, esc.Status.Conditions) ||
+			!reflect.DeepEqual(prevCertificateMigration, esc.Status.CertificateMigration) {
 			errUpdate = r.updateCondition(esc, err)
 			err = utilerrors.NewAggregate([]error{err, errUpdate})
 		}
@@ -446,19 +726,89 @@ func (r *Reconciler) processReconcileRequest(esc *operatorv1alpha1.ExternalSecre
 		Message:            "reconciliation successful",
 		ObservedGeneration: observedGeneration,
 	}
+	if managementState == operatorv1alpha1.Unmanaged {
+		readyCond.Message = "managementState is Unmanaged, operand resources are not reconciled"
+	}
+	// resources are in desired state, but the operand is ready only
+	// when the rollout of all the workloads is complete.
+	if !rollout.isComplete() {
+		readyCond.Status = metav1.ConditionFalse
+		readyCond.Reason = operatorv1alpha1.ReasonInProgress
+		readyCond.Message = fmt.Sprintf("waiting for operand rollout to complete: %s", rollout.message())
+		if rollout.isFailing() {
+			readyCond.Reason = operatorv1alpha1.ReasonFailed
+			readyCond.Message = fmt.Sprintf("operand rollout is failing: %s", rollout.message())
+		}
+	}
+	// the webhook cannot serve without the certificates issued by cert-manager, and
+	// the issuance failure is reported over the rollout waiting for the secrets.
+	certificatesNotReady := certificatesCond != nil && certificatesCond.Status == metav1.ConditionFalse
+	if certificatesNotReady {
+		readyCond.Status = metav1.ConditionFalse
+		readyCond.Reason = certificatesCond.Reason
+		readyCond.Message = fmt.Sprintf("waiting for certificates to be ready: %s", certificatesCond.Message)
+	}
 
-	if apimeta.SetStatusCondition(&esc.Status.Conditions, degradedCond) ||
-		apimeta.SetStatusCondition(&esc.Status.Conditions, readyCond) {
+	// the desired state is recorded only when it was applied on all the resources.
+	if managementState == operatorv1alpha1.Managed {
+		esc.Status.DesiredStateHash = r.inventory.hash()
+		esc.Status.LastAppliedGeneration = observedGeneration
+	}
+	rollout.setConditions(&esc.Status.Conditions, observedGeneration)
+	if certificatesCond != nil {
+		apimeta.SetStatusCondition(&esc.Status.Conditions, *certificatesCond)
+	} else {
+		apimeta.RemoveStatusCondition(&esc.Status.Conditions, operatorv1alpha1.CertificatesReady)
+	}
+	r.setCertificateExpiringCondition(esc, certificateExpiringCond)
+	readyCondChanged := apimeta.SetStatusCondition(&esc.Status.Conditions, readyCond)
+	apimeta.SetStatusCondition(&esc.Status.Conditions, degradedCond)
+	if !reflect.DeepEqual(prevConditions, esc.Status.Conditions) ||
+		prevDesiredStateHash != esc.Status.DesiredStateHash || prevLastAppliedGeneration != esc.Status.LastAppliedGeneration ||
+		!reflect.DeepEqual(prevCertificateMigration, esc.Status.CertificateMigration) {
 		errUpdate = r.updateCondition(esc, nil)
 	}
 
+	if certificatesNotReady && readyCondChanged && readyCond.Reason == operatorv1alpha1.ReasonFailed {
+		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "CertificatesNotReady", "%s", certificatesCond.Message)
+	}
+
+	if !rollout.isComplete() {
+		if readyCondChanged && rollout.isFailing() && !certificatesNotReady {
+			r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "RolloutFailing", "operand rollout is failing: %s", rollout.message())
+		}
+		r.log.V(1).Info("operand rollout in progress, requeuing", "request", req, "status", rollout.message())
+		return ctrl.Result{RequeueAfter: common.DefaultRequeueTime}, errUpdate
+	}
+
+	// the certificate secrets are not watched for data changes, hence
+	// the ongoing migration is checked periodically for progress.
+	if isCertificateMigrationInProgress(esc) {
+		r.log.V(1).Info("webhook certificate migration in progress, requeuing", "request", req, "phase", esc.Status.CertificateMigration.Phase)
+		return ctrl.Result{RequeueAfter: common.DefaultRequeueTime}, errUpdate
+	}
+
+	// user provided certificate secrets are not watched for data changes,
+	// hence are checked periodically for renewal and expiry.
+	if certificateExpiringCond != nil {
+		return ctrl.Result{RequeueAfter: userCertificateCheckInterval}, errUpdate
+	}
+
 	return ctrl.Result{}, errUpdate
 }
 
 // cleanUp handles deletion of externalsecretsconfigs.operator.openshift.io gracefully.
+// The resources are retained when the managementState is Unmanaged, irrespective of the deletionPolicy.
 func (r *Reconciler) cleanUp(esc *operatorv1alpha1.ExternalSecretsConfig, req ctrl.Request) (bool, error) {
-	// TODO: For GA, handle cleaning up of resources created for installing external-secrets operand.
-	r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "RemoveDeployment", "%s/%s externalsecretsconfigs.operator.openshift.io marked for deletion, remove reference in deployment and remove all resources created for deployment", esc.GetNamespace(), esc.GetName())
+	if esc.Spec.DeletionPolicy == operatorv1alpha1.DeletionPolicyDelete && getManagementState(esc) != operatorv1alpha1.Unmanaged {
+		inProgress, err := r.deleteExternalSecretsDeployment(esc)
+		if err != nil || inProgress {
+			return true, err
+		}
+		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "RemoveDeployment", "%s externalsecretsconfigs.operator.openshift.io marked for deletion, all resources created for external-secrets deployment are deleted", esc.GetName())
+	} else {
+		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "RemoveDeployment", "%s/%s externalsecretsconfigs.operator.openshift.io marked for deletion, remove reference in deployment and remove all resources created for deployment", esc.GetNamespace(), esc.GetName())
+	}
 
 	if err := common.RemoveFinalizer(r.ctx, esc, r.CtrlClient, finalizer); err != nil {
 		return true, err
@@ -3,16 +3,17 @@ package external_secrets
 import (
 	"fmt"
 	"os"
+	"strings"
 	"unsafe"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/kubernetes/pkg/apis/core"
 	corevalidation "k8s.io/kubernetes/pkg/apis/core/validation"
 	"k8s.io/utils/ptr"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/common"
@@ -21,6 +22,21 @@ import (
 
 // createOrApplyDeployments ensures required Deployment resources exist and are correctly configured.
 func (r *Reconciler) createOrApplyDeployments(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string, externalSecretsConfigCreateRecon bool) error {
+	for _, assetName := range getDeploymentAssetNames(esc) {
+		if err := r.createOrApplyDeploymentFromAsset(esc, assetName, resourceLabels, externalSecretsConfigCreateRecon); err != nil {
+			return err
+		}
+	}
+
+	if err := r.updateImageInStatus(esc); err != nil {
+		return common.FromClientError(err, "failed to update %s/%s status with image info", esc.GetNamespace(), esc.GetName())
+	}
+
+	return nil
+}
+
+// getDeploymentAssetNames returns the Deployment assets required for the configuration.
+func getDeploymentAssetNames(esc *operatorv1alpha1.ExternalSecretsConfig) []string {
 	// Define all Deployment assets to apply based on conditions.
 	deployments := []struct {
 		assetName string
@@ -36,7 +52,7 @@ func (r *Reconciler) createOrApplyDeployments(esc *operatorv1alpha1.ExternalSecr
 		},
 		{
 			assetName: certControllerDeploymentAssetName,
-			condition: !isCertManagerConfigEnabled(esc),
+			condition: isCertControllerEnabled(esc),
 		},
 		{
 			assetName: bitwardenDeploymentAssetName,
@@ -44,57 +60,23 @@ func (r *Reconciler) createOrApplyDeployments(esc *operatorv1alpha1.ExternalSecr
 		},
 	}
 
-	// Apply deployments based on the specified conditions.
+	assetNames := make([]string, 0, len(deployments))
 	for _, d := range deployments {
-		if !d.condition {
-			continue
-		}
-		if err := r.createOrApplyDeploymentFromAsset(esc, d.assetName, resourceLabels, externalSecretsConfigCreateRecon); err != nil {
-			return err
+		if d.condition {
+			assetNames = append(assetNames, d.assetName)
 		}
 	}
-
-	if err := r.updateImageInStatus(esc); err != nil {
-		return common.FromClientError(err, "failed to update %s/%s status with image info", esc.GetNamespace(), esc.GetName())
-	}
-
-	return nil
+	return assetNames
 }
 
 func (r *Reconciler) createOrApplyDeploymentFromAsset(esc *operatorv1alpha1.ExternalSecretsConfig, assetName string, resourceLabels map[string]string,
 	externalSecretsConfigCreateRecon bool,
 ) error {
-
 	deployment, err := r.getDeploymentObject(assetName, esc, resourceLabels)
 	if err != nil {
 		return err
 	}
-
-	deploymentName := fmt.Sprintf("%s/%s", deployment.GetNamespace(), deployment.GetName())
-	fetched := &appsv1.Deployment{}
-	exist, err := r.Exists(r.ctx, client.ObjectKeyFromObject(deployment), fetched)
-	if err != nil {
-		return common.FromClientError(err, "failed to check %s deployment resource already exists", deploymentName)
-	}
-	if exist && externalSecretsConfigCreateRecon {
-		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "%s deployment resource already exists", deploymentName)
-	}
-	if exist && common.HasObjectChanged(deployment, fetched) {
-		r.log.V(1).Info("deployment has been modified, updating to desired state", "name", deploymentName)
-		if err := r.UpdateWithRetry(r.ctx, deployment); err != nil {
-			return common.FromClientError(err, "failed to update %s deployment resource", deploymentName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "deployment resource %s updated", deploymentName)
-	} else if !exist {
-		if err := r.Create(r.ctx, deployment); err != nil {
-			return common.FromClientError(err, "failed to create %s deployment resource", deploymentName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "deployment resource %s created", deploymentName)
-	} else {
-		r.log.V(4).Info("deployment resource already exists and is in expected state", "name", deploymentName)
-	}
-
-	return nil
+	return r.applyResource(esc, deployment, &appsv1.Deployment{}, "deployment", externalSecretsConfigCreateRecon)
 }
 
 func (r *Reconciler) getDeploymentObject(assetName string, esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string) (*appsv1.Deployment, error) {
@@ -102,6 +84,7 @@ func (r *Reconciler) getDeploymentObject(assetName string, esc *operatorv1alpha1
 	updateNamespace(deployment, esc)
 	common.UpdateResourceLabels(deployment, resourceLabels)
 	updatePodTemplateLabels(deployment, resourceLabels)
+	deployment.Spec.Replicas = ptr.To(getComponentReplicas(esc, assetName))
 
 	image := os.Getenv(externalsecretsImageEnvVarName)
 	if image == "" {
@@ -123,7 +106,7 @@ func (r *Reconciler) getDeploymentObject(assetName string, esc *operatorv1alpha1
 			checkInterval = esc.Spec.ApplicationConfig.WebhookConfig.CertificateCheckInterval.Duration.String()
 		}
 		updateWebhookContainerSpec(deployment, image, logLevel, checkInterval)
-		updateWebhookVolumeConfig(deployment, esc)
+		updateWebhookVolumeConfig(deployment, getWebhookCertificateProvider(esc))
 	case certControllerDeploymentAssetName:
 		updateCertControllerContainerSpec(deployment, image, logLevel)
 	case bitwardenDeploymentAssetName:
@@ -132,18 +115,34 @@ func (r *Reconciler) getDeploymentObject(assetName string, esc *operatorv1alpha1
 		updateBitwardenVolumeConfig(deployment, esc)
 	}
 
-	if err := r.updateResourceRequirement(deployment, esc); err != nil {
+	if err := r.updateResourceRequirement(deployment, esc, assetName); err != nil {
 		return nil, fmt.Errorf("failed to update resource requirements: %w", err)
 	}
-	if err := r.updateAffinityRules(deployment, esc); err != nil {
+	if err := r.updateAffinityRules(deployment, esc, assetName); err != nil {
 		return nil, fmt.Errorf("failed to update affinity rules: %w", err)
 	}
-	if err := r.updatePodTolerations(deployment, esc); err != nil {
+	if err := updateTopologySpreadConstraints(deployment, esc, assetName); err != nil {
+		return nil, fmt.Errorf("failed to update topology spread constraints: %w", err)
+	}
+	updateHighAvailabilityDefaults(deployment)
+	if err := r.updatePodTolerations(deployment, esc, assetName); err != nil {
 		return nil, fmt.Errorf("failed to update pod tolerations: %w", err)
 	}
-	if err := r.updateNodeSelector(deployment, esc); err != nil {
+	if err := r.updateNodeSelector(deployment, esc, assetName); err != nil {
 		return nil, fmt.Errorf("failed to update node selector: %w", err)
 	}
+	if err := updatePriorityClassName(deployment, esc, assetName); err != nil {
+		return nil, fmt.Errorf("failed to update priority class name: %w", err)
+	}
+	if err := r.updateProxyConfig(deployment, esc); err != nil {
+		return nil, err
+	}
+	// cert-controller only interacts with the API server, and does not require the additional CA certificates.
+	if assetName != certControllerDeploymentAssetName {
+		if err := r.updateTrustedCABundleConfig(deployment, esc); err != nil {
+			return nil, err
+		}
+	}
 
 	return deployment, nil
 }
@@ -174,10 +173,15 @@ func updateContainerSecurityContext(container *corev1.Container) {
 	}
 }
 
-// updateResourceRequirement sets validated resource requirements to all containers.
-func (r *Reconciler) updateResourceRequirement(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig) error {
+// updateResourceRequirement sets validated resource requirements to all containers. The requirements configured
+// for the component take precedence over the ones configured for all the components.
+func (r *Reconciler) updateResourceRequirement(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig, assetName string) error {
 	rscReqs := corev1.ResourceRequirements{}
-	if esc.Spec.ApplicationConfig.Resources != nil {
+	fldPath := field.NewPath("spec")
+	if component, componentPath := getComponentConfig(esc, assetName); component != nil && component.Resources != nil {
+		component.Resources.DeepCopyInto(&rscReqs)
+		fldPath = componentPath
+	} else if esc.Spec.ApplicationConfig.Resources != nil {
 		esc.Spec.ApplicationConfig.Resources.DeepCopyInto(&rscReqs)
 	} else if r.esm.Spec.GlobalConfig != nil && r.esm.Spec.GlobalConfig.Resources != nil {
 		r.esm.Spec.GlobalConfig.Resources.DeepCopyInto(&rscReqs)
@@ -186,7 +190,7 @@ func (r *Reconciler) updateResourceRequirement(deployment *appsv1.Deployment, es
 	}
 
 	// Validate the resource requirements
-	if err := validateResourceRequirements(rscReqs, field.NewPath("spec")); err != nil {
+	if err := validateResourceRequirements(rscReqs, fldPath).ToAggregate(); err != nil {
 		return fmt.Errorf("invalid resource requirements: %w", err)
 	}
 
@@ -199,17 +203,21 @@ func (r *Reconciler) updateResourceRequirement(deployment *appsv1.Deployment, es
 }
 
 // validateResourceRequirements validates the resource request/limit configuration.
-func validateResourceRequirements(requirements corev1.ResourceRequirements, fldPath *field.Path) error {
+func validateResourceRequirements(requirements corev1.ResourceRequirements, fldPath *field.Path) field.ErrorList {
 	// convert corev1.ResourceRequirements to core.ResourceRequirements, required for validation.
 	convRequirements := *(*core.ResourceRequirements)(unsafe.Pointer(&requirements))
-	return corevalidation.ValidateContainerResourceRequirements(&convRequirements, nil, fldPath.Child("resources"), corevalidation.PodValidationOptions{}).ToAggregate()
+	return corevalidation.ValidateContainerResourceRequirements(&convRequirements, nil, fldPath.Child("resources"), corevalidation.PodValidationOptions{})
 }
 
 // updateNodeSelector sets and validates node selector constraints.
-func (r *Reconciler) updateNodeSelector(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig) error {
+func (r *Reconciler) updateNodeSelector(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig, assetName string) error {
 	var nodeSelector map[string]string
+	fldPath := field.NewPath("spec")
 
-	if esc.Spec.ApplicationConfig.NodeSelector != nil {
+	if component, componentPath := getComponentConfig(esc, assetName); component != nil && component.NodeSelector != nil {
+		nodeSelector = component.NodeSelector
+		fldPath = componentPath
+	} else if esc.Spec.ApplicationConfig.NodeSelector != nil {
 		nodeSelector = esc.Spec.ApplicationConfig.NodeSelector
 	} else if r.esm.Spec.GlobalConfig != nil && r.esm.Spec.GlobalConfig.NodeSelector != nil {
 		nodeSelector = r.esm.Spec.GlobalConfig.NodeSelector
@@ -219,7 +227,7 @@ func (r *Reconciler) updateNodeSelector(deployment *appsv1.Deployment, esc *oper
 		return nil
 	}
 
-	if err := validateNodeSelectorConfig(nodeSelector, field.NewPath("spec")); err != nil {
+	if err := validateNodeSelectorConfig(nodeSelector, fldPath).ToAggregate(); err != nil {
 		return err
 	}
 
@@ -228,10 +236,14 @@ func (r *Reconciler) updateNodeSelector(deployment *appsv1.Deployment, esc *oper
 }
 
 // updateAffinityRules sets and validates pod affinity/anti-affinity rules.
-func (r *Reconciler) updateAffinityRules(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig) error {
+func (r *Reconciler) updateAffinityRules(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig, assetName string) error {
 	var affinity *corev1.Affinity
+	fldPath := field.NewPath("spec", "affinity")
 
-	if esc.Spec.ApplicationConfig.Affinity != nil {
+	if component, componentPath := getComponentConfig(esc, assetName); component != nil && component.Affinity != nil {
+		affinity = component.Affinity
+		fldPath = componentPath
+	} else if esc.Spec.ApplicationConfig.Affinity != nil {
 		affinity = esc.Spec.ApplicationConfig.Affinity
 	} else if r.esm.Spec.GlobalConfig != nil && r.esm.Spec.GlobalConfig.Affinity != nil {
 		affinity = r.esm.Spec.GlobalConfig.Affinity
@@ -241,7 +253,7 @@ func (r *Reconciler) updateAffinityRules(deployment *appsv1.Deployment, esc *ope
 		return nil
 	}
 
-	if err := validateAffinityRules(affinity, field.NewPath("spec", "affinity")); err != nil {
+	if err := validateAffinityRules(affinity, fldPath).ToAggregate(); err != nil {
 		return err
 	}
 
@@ -249,11 +261,53 @@ func (r *Reconciler) updateAffinityRules(deployment *appsv1.Deployment, esc *ope
 	return nil
 }
 
+// updateHighAvailabilityDefaults is for spreading the pods of a deployment with more than one replica across
+// the nodes and zones, for the component to remain available on losing a node or a zone. The pods are spread
+// on best-effort basis, to not have them unschedulable in the clusters with fewer nodes or zones. The default
+// anti-affinity is not set when the affinity rules are configured by the user.
+func updateHighAvailabilityDefaults(deployment *appsv1.Deployment) {
+	if ptr.Deref(deployment.Spec.Replicas, 1) <= 1 {
+		return
+	}
+	podSelector := deployment.Spec.Selector.DeepCopy()
+
+	if deployment.Spec.Template.Spec.Affinity == nil {
+		deployment.Spec.Template.Spec.Affinity = &corev1.Affinity{
+			PodAntiAffinity: &corev1.PodAntiAffinity{
+				PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{
+					{
+						Weight: 100,
+						PodAffinityTerm: corev1.PodAffinityTerm{
+							LabelSelector: podSelector,
+							TopologyKey:   corev1.LabelHostname,
+						},
+					},
+				},
+			},
+		}
+	}
+
+	if len(deployment.Spec.Template.Spec.TopologySpreadConstraints) == 0 {
+		deployment.Spec.Template.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{
+			{
+				MaxSkew:           1,
+				TopologyKey:       corev1.LabelTopologyZone,
+				WhenUnsatisfiable: corev1.ScheduleAnyway,
+				LabelSelector:     podSelector,
+			},
+		}
+	}
+}
+
 // updatePodTolerations sets and validates pod tolerations.
-func (r *Reconciler) updatePodTolerations(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig) error {
+func (r *Reconciler) updatePodTolerations(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig, assetName string) error {
 	var tolerations []corev1.Toleration
+	fldPath := field.NewPath("spec", "tolerations")
 
-	if esc.Spec.ApplicationConfig.Tolerations != nil {
+	if component, componentPath := getComponentConfig(esc, assetName); component != nil && component.Tolerations != nil {
+		tolerations = component.Tolerations
+		fldPath = componentPath
+	} else if esc.Spec.ApplicationConfig.Tolerations != nil {
 		tolerations = esc.Spec.ApplicationConfig.Tolerations
 	} else if r.esm.Spec.GlobalConfig != nil && r.esm.Spec.GlobalConfig.Tolerations != nil {
 		tolerations = r.esm.Spec.GlobalConfig.Tolerations
@@ -263,7 +317,7 @@ func (r *Reconciler) updatePodTolerations(deployment *appsv1.Deployment, esc *op
 		return nil
 	}
 
-	if err := validateTolerationsConfig(tolerations, field.NewPath("spec", "tolerations")); err != nil {
+	if err := validateTolerationsConfig(tolerations, fldPath).ToAggregate(); err != nil {
 		return err
 	}
 
@@ -271,23 +325,68 @@ func (r *Reconciler) updatePodTolerations(deployment *appsv1.Deployment, esc *op
 	return nil
 }
 
+// updateTopologySpreadConstraints sets and validates the topology spread constraints configured for the component.
+func updateTopologySpreadConstraints(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig, assetName string) error {
+	component, fldPath := getComponentConfig(esc, assetName)
+	if component == nil || len(component.TopologySpreadConstraints) == 0 {
+		return nil
+	}
+
+	if err := validateTopologySpreadConstraints(component.TopologySpreadConstraints, fldPath).ToAggregate(); err != nil {
+		return err
+	}
+
+	deployment.Spec.Template.Spec.TopologySpreadConstraints = component.TopologySpreadConstraints
+	return nil
+}
+
+// updatePriorityClassName sets and validates the priority class name configured for the component.
+func updatePriorityClassName(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig, assetName string) error {
+	component, fldPath := getComponentConfig(esc, assetName)
+	if component == nil || component.PriorityClassName == "" {
+		return nil
+	}
+
+	if err := validatePriorityClassName(component.PriorityClassName, fldPath).ToAggregate(); err != nil {
+		return err
+	}
+
+	deployment.Spec.Template.Spec.PriorityClassName = component.PriorityClassName
+	return nil
+}
+
+// validatePriorityClassName validates the PriorityClass name configuration.
+func validatePriorityClassName(name string, fldPath *field.Path) field.ErrorList {
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) != 0 {
+		return field.ErrorList{field.Invalid(fldPath.Child("priorityClassName"), name, strings.Join(errs, ", "))}
+	}
+	return nil
+}
+
 // validateNodeSelectorConfig validates the NodeSelector configuration.
-func validateNodeSelectorConfig(nodeSelector map[string]string, fldPath *field.Path) error {
-	return metav1validation.ValidateLabels(nodeSelector, fldPath.Child("nodeSelector")).ToAggregate()
+func validateNodeSelectorConfig(nodeSelector map[string]string, fldPath *field.Path) field.ErrorList {
+	return metav1validation.ValidateLabels(nodeSelector, fldPath.Child("nodeSelector"))
 }
 
 // validateAffinityRules validates the Affinity configuration.
-func validateAffinityRules(affinity *corev1.Affinity, fldPath *field.Path) error {
+func validateAffinityRules(affinity *corev1.Affinity, fldPath *field.Path) field.ErrorList {
 	// convert corev1.Affinity to core.Affinity, required for validation.
 	convAffinity := (*core.Affinity)(unsafe.Pointer(affinity))
-	return common.ValidateAffinity(convAffinity, corevalidation.PodValidationOptions{}, fldPath.Child("affinity")).ToAggregate()
+	return common.ValidateAffinity(convAffinity, corevalidation.PodValidationOptions{}, fldPath.Child("affinity"))
+}
+
+// validateTopologySpreadConstraints validates the TopologySpreadConstraints configuration.
+func validateTopologySpreadConstraints(constraints []corev1.TopologySpreadConstraint, fldPath *field.Path) field.ErrorList {
+	// convert corev1.TopologySpreadConstraints to core.TopologySpreadConstraints, required for validation.
+	convConstraints := *(*[]core.TopologySpreadConstraint)(unsafe.Pointer(&constraints))
+	return common.ValidateTopologySpreadConstraints(convConstraints, fldPath.Child("topologySpreadConstraints"))
 }
 
 // validateTolerationsConfig validates the toleration configuration.
-func validateTolerationsConfig(tolerations []corev1.Toleration, fldPath *field.Path) error {
+func validateTolerationsConfig(tolerations []corev1.Toleration, fldPath *field.Path) field.ErrorList {
 	// convert corev1.Tolerations to core.Tolerations, required for validation.
 	convTolerations := *(*[]core.Toleration)(unsafe.Pointer(&tolerations))
-	return corevalidation.ValidateTolerations(convTolerations, fldPath.Child("tolerations")).ToAggregate()
+	return corevalidation.ValidateTolerations(convTolerations, fldPath.Child("tolerations"))
 }
 
 func (r *Reconciler) updateImageInStatus(esc *operatorv1alpha1.ExternalSecretsConfig) error {
@@ -304,31 +403,56 @@ func (r *Reconciler) updateImageInStatus(esc *operatorv1alpha1.ExternalSecretsCo
 // argument list for external-secrets deployment resource
 func updateContainerSpec(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig, image, logLevel string) {
 	var (
-		enableClusterStoreArgFmt           = "--enable-cluster-store-reconciler=%s"
-		enableClusterExternalSecretsArgFmt = "--enable-cluster-external-secret-reconciler=%s"
+		enableClusterStoreArgFmt           = "--enable-cluster-store-reconciler=%t"
+		enableClusterExternalSecretsArgFmt = "--enable-cluster-external-secret-reconciler=%t"
+		enableClusterPushSecretsArgFmt     = "--enable-cluster-push-secret-reconciler=%t"
 	)
 
+	config := esc.Spec.ApplicationConfig.Controller
+	concurrent := int32(1)
+	if config != nil && config.Concurrent != 0 {
+		concurrent = config.Concurrent
+	}
+
 	args := []string{
-		"--concurrent=1",
+		fmt.Sprintf("--concurrent=%d", concurrent),
 		"--metrics-addr=:8080",
 		fmt.Sprintf("--loglevel=%s", logLevel),
 		"--zap-time-encoding=epoch",
 		"--enable-leader-election=true",
-		"--enable-push-secret-reconciler=true",
+		fmt.Sprintf("--enable-push-secret-reconciler=%t", isPushSecretEnabled(esc)),
+	}
+
+	// the tuning flags are passed only when configured, for the operand defaults to be used otherwise.
+	if config != nil {
+		if config.StoreRequeueInterval != nil {
+			args = append(args, fmt.Sprintf("--store-requeue-interval=%s", config.StoreRequeueInterval.Duration.String()))
+		}
+		if config.ClientQPS != 0 {
+			args = append(args, fmt.Sprintf("--client-qps=%d", config.ClientQPS))
+		}
+		if config.ClientBurst != 0 {
+			args = append(args, fmt.Sprintf("--client-burst=%d", config.ClientBurst))
+		}
+		if config.EnableSecretsCaching != "" {
+			args = append(args, fmt.Sprintf("--enable-secrets-caching=%t", common.EvalMode(config.EnableSecretsCaching)))
+		}
+		if config.EnableConfigMapsCaching != "" {
+			args = append(args, fmt.Sprintf("--enable-configmaps-caching=%t", common.EvalMode(config.EnableConfigMapsCaching)))
+		}
 	}
 
 	// when spec.appConfig.operatingNamespace is configured, which is for restricting the
 	// external-secrets custom resource reconcile scope to specified namespace, the reconciliation
-	// of cluster scoped custom resources must also be disabled.
-	namespace := getOperatingNamespace(esc)
-	if namespace != "" {
-		args = append(args, fmt.Sprintf("--namespace=%s", namespace),
-			fmt.Sprintf(enableClusterStoreArgFmt, "false"),
-			fmt.Sprintf(enableClusterExternalSecretsArgFmt, "false"))
-	} else {
-		args = append(args, fmt.Sprintf(enableClusterStoreArgFmt, "true"),
-			fmt.Sprintf(enableClusterExternalSecretsArgFmt, "true"))
-	}
+	// of cluster scoped custom resources must also be disabled, irrespective of the features config.
+	if namespace := getOperatingNamespace(esc); namespace != "" {
+		args = append(args, fmt.Sprintf("--namespace=%s", namespace))
+	}
+	args = append(args,
+		fmt.Sprintf(enableClusterStoreArgFmt, isClusterSecretStoreEnabled(esc)),
+		fmt.Sprintf(enableClusterExternalSecretsArgFmt, isClusterExternalSecretEnabled(esc)),
+		fmt.Sprintf(enableClusterPushSecretsArgFmt, isClusterPushSecretEnabled(esc)),
+	)
 
 	for i, container := range deployment.Spec.Template.Spec.Containers {
 		if container.Name == "external-secrets" {
@@ -404,17 +528,189 @@ func updateBitwardenServerContainerSpec(deployment *appsv1.Deployment, image str
 }
 
 func updateBitwardenVolumeConfig(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig) {
-	if esc.Spec.Plugins.BitwardenSecretManagerProvider.SecretRef != nil &&
-		esc.Spec.Plugins.BitwardenSecretManagerProvider.SecretRef.Name != "" {
+	if isBitwardenSecretRefConfigured(esc) {
 		secretName := esc.Spec.Plugins.BitwardenSecretManagerProvider.SecretRef.Name
 		updateSecretVolumeConfig(deployment, "bitwarden-tls-certs", secretName)
+		return
+	}
+	if common.IsServiceCAConfigEnabled(esc) {
+		updateServiceCAVolumeConfig(deployment, "bitwarden-tls-certs", serviceCATLSSecretBitwarden, "cert.pem", "key.pem", "ca.pem")
+	}
+}
+
+func updateWebhookVolumeConfig(deployment *appsv1.Deployment, provider operatorv1alpha1.CertificateProviderReference) {
+	switch provider.Provider {
+	case operatorv1alpha1.CertManagerProvider, operatorv1alpha1.UserProvidedProvider:
+		updateSecretVolumeConfig(deployment, "certs", provider.SecretName)
+	case operatorv1alpha1.ServiceCAProvider:
+		updateServiceCAVolumeConfig(deployment, "certs", provider.SecretName, "tls.crt", "tls.key", "ca.crt")
+	}
+}
+
+// updateServiceCAVolumeConfig replaces the volume with a projected volume of the serving certificate generated by
+// the OpenShift service-ca operator and the service CA bundle, as the generated secret does not have the CA
+// certificate. Neither source is optional, for the pods to not start before service-ca has populated them.
+func updateServiceCAVolumeConfig(deployment *appsv1.Deployment, volumeName, secretName, certPath, keyPath, caPath string) {
+	source := &corev1.ProjectedVolumeSource{
+		Sources: []corev1.VolumeProjection{
+			{
+				Secret: &corev1.SecretProjection{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: secretName,
+					},
+					Items: []corev1.KeyToPath{
+						{
+							Key:  corev1.TLSCertKey,
+							Path: certPath,
+						},
+						{
+							Key:  corev1.TLSPrivateKeyKey,
+							Path: keyPath,
+						},
+					},
+				},
+			},
+			{
+				ConfigMap: &corev1.ConfigMapProjection{
+					LocalObjectReference: corev1.LocalObjectReference{
+						Name: getServiceCABundleConfigMapName(),
+					},
+					Items: []corev1.KeyToPath{
+						{
+							Key:  serviceCABundleKey,
+							Path: caPath,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for i := range deployment.Spec.Template.Spec.Volumes {
+		if deployment.Spec.Template.Spec.Volumes[i].Name == volumeName {
+			deployment.Spec.Template.Spec.Volumes[i].VolumeSource = corev1.VolumeSource{
+				Projected: source,
+			}
+			return
+		}
 	}
+
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: source,
+		},
+	})
 }
 
-func updateWebhookVolumeConfig(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig) {
-	if isCertManagerConfigEnabled(esc) {
-		updateSecretVolumeConfig(deployment, "certs", certmanagerTLSSecretWebhook)
+// updateTrustedCABundleConfig mounts the configured trusted CA bundles into all containers of the deployment
+// and sets SSL_CERT_DIR to include them, along with the hash of the bundles for rolling out the pods on change.
+func (r *Reconciler) updateTrustedCABundleConfig(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig) error {
+	ref := getTrustedCABundleConfigMapRef(esc)
+	if !isClusterTrustedCABundleEnabled(esc) && ref == nil {
+		return nil
 	}
+
+	hash, err := r.getTrustedCABundleHash(esc)
+	if err != nil {
+		return err
+	}
+
+	var certDirs []string
+	if isClusterTrustedCABundleEnabled(esc) {
+		// CA bundle is injected asynchronously, hence marked optional for the pods to not
+		// be stuck till then.
+		updateConfigMapVolumeConfig(deployment, clusterTrustedCABundleVolumeName, r.getTrustedCABundleConfigMapObject(esc, nil).GetName(), trustedCABundleKey, true)
+		updateVolumeMountConfig(deployment, clusterTrustedCABundleVolumeName, clusterTrustedCABundleMountPath)
+		certDirs = append(certDirs, clusterTrustedCABundleMountPath)
+	}
+	if ref != nil {
+		updateConfigMapVolumeConfig(deployment, trustedCABundleVolumeName, ref.Name, getTrustedCABundleKey(ref), false)
+		updateVolumeMountConfig(deployment, trustedCABundleVolumeName, trustedCABundleMountPath)
+		certDirs = append(certDirs, trustedCABundleMountPath)
+	}
+	certDirs = append(certDirs, systemCertDirs...)
+
+	for i := range deployment.Spec.Template.Spec.Containers {
+		updateEnvVar(&deployment.Spec.Template.Spec.Containers[i], sslCertDirEnvVarName, strings.Join(certDirs, ":"))
+	}
+
+	annotations := deployment.Spec.Template.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[trustedCABundleHashAnnotation] = hash
+	deployment.Spec.Template.SetAnnotations(annotations)
+
+	return nil
+}
+
+// updateEnvVar sets the environment variable in the container, replacing the existing value if any.
+func updateEnvVar(container *corev1.Container, name, value string) {
+	for i := range container.Env {
+		if container.Env[i].Name == name {
+			container.Env[i].Value = value
+			container.Env[i].ValueFrom = nil
+			return
+		}
+	}
+	container.Env = append(container.Env, corev1.EnvVar{Name: name, Value: value})
+}
+
+// updateVolumeMountConfig mounts the volume read-only at the given path in all containers of the deployment.
+func updateVolumeMountConfig(deployment *appsv1.Deployment, volumeName, mountPath string) {
+	for i := range deployment.Spec.Template.Spec.Containers {
+		container := &deployment.Spec.Template.Spec.Containers[i]
+		mount := corev1.VolumeMount{
+			Name:      volumeName,
+			MountPath: mountPath,
+			ReadOnly:  true,
+		}
+		found := false
+		for j := range container.VolumeMounts {
+			if container.VolumeMounts[j].Name == volumeName {
+				container.VolumeMounts[j] = mount
+				found = true
+				break
+			}
+		}
+		if !found {
+			container.VolumeMounts = append(container.VolumeMounts, mount)
+		}
+	}
+}
+
+// updateConfigMapVolumeConfig is same as updateSecretVolumeConfig but for the ConfigMap volumes, with
+// only the provided key projected into the volume as `ca-bundle.crt`.
+func updateConfigMapVolumeConfig(deployment *appsv1.Deployment, volumeName, configMapName, key string, optional bool) {
+	source := &corev1.ConfigMapVolumeSource{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: configMapName,
+		},
+		Items: []corev1.KeyToPath{
+			{
+				Key:  key,
+				Path: trustedCABundleKey,
+			},
+		},
+		Optional: ptr.To(optional),
+	}
+
+	for i := range deployment.Spec.Template.Spec.Volumes {
+		if deployment.Spec.Template.Spec.Volumes[i].Name == volumeName {
+			deployment.Spec.Template.Spec.Volumes[i].VolumeSource = corev1.VolumeSource{
+				ConfigMap: source,
+			}
+			return
+		}
+	}
+
+	deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: source,
+		},
+	})
 }
 
 func updateSecretVolumeConfig(deployment *appsv1.Deployment, volumeName, secretName string) {
@@ -2,13 +2,18 @@ package external_secrets
 
 import (
 	"context"
+	"reflect"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/openshift/external-secrets-operator/api/v1alpha1"
@@ -36,7 +41,7 @@ func TestCreateOrApplyDeployments(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, _ ...client.PatchOption) error {
 					switch o := obj.(type) {
 					case *appsv1.Deployment:
 						*capturedDeployment = o.DeepCopy()
@@ -106,7 +111,7 @@ func TestCreateOrApplyDeployments(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, _ ...client.PatchOption) error {
 					switch obj.(type) {
 					case *appsv1.Deployment:
 						return commontest.TestClientError
@@ -114,7 +119,7 @@ func TestCreateOrApplyDeployments(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: `failed to update external-secrets/external-secrets deployment resource: test client error`,
+			wantErr: `failed to apply external-secrets/external-secrets deployment resource: test client error`,
 		},
 		{
 			name: "deployment reconciliation with user custom config successful",
@@ -127,7 +132,7 @@ func TestCreateOrApplyDeployments(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, _ ...client.PatchOption) error {
 					switch o := obj.(type) {
 					case *appsv1.Deployment:
 						*capturedDeployment = o.DeepCopy()
@@ -319,7 +324,7 @@ func TestCreateOrApplyDeployments(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch o := obj.(type) {
 					case *appsv1.Deployment:
 						*capturedDeployment = o.DeepCopy()
@@ -492,7 +497,7 @@ func TestCreateOrApplyDeployments(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, _ ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, _ ...client.PatchOption) error {
 					switch o := obj.(type) {
 					case *appsv1.Deployment:
 						*capturedDeployment = o.DeepCopy()
@@ -599,3 +604,449 @@ func TestCreateOrApplyDeployments(t *testing.T) {
 		})
 	}
 }
+
+func TestDeploymentReplicasAndHighAvailabilityDefaults(t *testing.T) {
+	userAffinity := &corev1.Affinity{
+		NodeAffinity: &corev1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+				NodeSelectorTerms: []corev1.NodeSelectorTerm{
+					{
+						MatchExpressions: []corev1.NodeSelectorRequirement{
+							{Key: "node-role.kubernetes.io/infra", Operator: corev1.NodeSelectorOpExists},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		assetName           string
+		replicas            *v1alpha1.ComponentReplicas
+		affinity            *corev1.Affinity
+		wantReplicas        int32
+		wantDefaultAffinity bool
+		wantSpread          bool
+	}{
+		{
+			name:         "replicas not configured",
+			assetName:    controllerDeploymentAssetName,
+			wantReplicas: 1,
+		},
+		{
+			name:                "controller with multiple replicas",
+			assetName:           controllerDeploymentAssetName,
+			replicas:            &v1alpha1.ComponentReplicas{Controller: ptr.To(int32(3))},
+			wantReplicas:        3,
+			wantDefaultAffinity: true,
+			wantSpread:          true,
+		},
+		{
+			name:         "webhook with a single replica",
+			assetName:    webhookDeploymentAssetName,
+			replicas:     &v1alpha1.ComponentReplicas{Controller: ptr.To(int32(3)), Webhook: ptr.To(int32(1))},
+			wantReplicas: 1,
+		},
+		{
+			name:         "webhook with multiple replicas and user configured affinity",
+			assetName:    webhookDeploymentAssetName,
+			replicas:     &v1alpha1.ComponentReplicas{Webhook: ptr.To(int32(2))},
+			affinity:     userAffinity,
+			wantReplicas: 2,
+			wantSpread:   true,
+		},
+		{
+			name:         "cert-controller does not support replicas",
+			assetName:    certControllerDeploymentAssetName,
+			replicas:     &v1alpha1.ComponentReplicas{Controller: ptr.To(int32(3)), Webhook: ptr.To(int32(3))},
+			wantReplicas: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			t.Setenv("RELATED_IMAGE_EXTERNAL_SECRETS", commontest.TestExternalSecretsImageName)
+			t.Setenv("RELATED_IMAGE_BITWARDEN_SDK_SERVER", commontest.TestBitwardenImageName)
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ApplicationConfig.Replicas = tt.replicas
+			esc.Spec.ApplicationConfig.Affinity = tt.affinity
+
+			deployment, err := r.getDeploymentObject(tt.assetName, esc, controllerDefaultResourceLabels)
+			if err != nil {
+				t.Fatalf("getDeploymentObject() err: %v", err)
+			}
+			if got := ptr.Deref(deployment.Spec.Replicas, 0); got != tt.wantReplicas {
+				t.Errorf("getDeploymentObject() replicas: %d, want: %d", got, tt.wantReplicas)
+			}
+
+			affinity := deployment.Spec.Template.Spec.Affinity
+			switch {
+			case tt.wantDefaultAffinity:
+				if affinity == nil || affinity.PodAntiAffinity == nil || len(affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution) != 1 ||
+					!reflect.DeepEqual(affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution[0].PodAffinityTerm.LabelSelector, deployment.Spec.Selector) {
+					t.Errorf("getDeploymentObject() default pod anti-affinity not set: %+v", affinity)
+				}
+			case tt.affinity != nil:
+				if !reflect.DeepEqual(affinity, tt.affinity) {
+					t.Errorf("getDeploymentObject() user configured affinity must be retained, got: %+v", affinity)
+				}
+			default:
+				if affinity != nil {
+					t.Errorf("getDeploymentObject() affinity must not be set, got: %+v", affinity)
+				}
+			}
+
+			constraints := deployment.Spec.Template.Spec.TopologySpreadConstraints
+			if tt.wantSpread != (len(constraints) == 1) {
+				t.Errorf("getDeploymentObject() topology spread constraints: %+v, want set: %v", constraints, tt.wantSpread)
+			}
+			if tt.wantSpread && (constraints[0].TopologyKey != corev1.LabelTopologyZone || constraints[0].WhenUnsatisfiable != corev1.ScheduleAnyway) {
+				t.Errorf("getDeploymentObject() unexpected topology spread constraint: %+v", constraints[0])
+			}
+		})
+	}
+}
+
+func TestDeploymentScaleDownDriftCorrection(t *testing.T) {
+	r := testReconciler(t)
+	t.Setenv("RELATED_IMAGE_EXTERNAL_SECRETS", commontest.TestExternalSecretsImageName)
+	t.Setenv("RELATED_IMAGE_BITWARDEN_SDK_SERVER", commontest.TestBitwardenImageName)
+
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ApplicationConfig.Replicas = &v1alpha1.ComponentReplicas{Webhook: ptr.To(int32(2))}
+	fetched, err := r.getDeploymentObject(webhookDeploymentAssetName, esc, controllerDefaultResourceLabels)
+	if err != nil {
+		t.Fatalf("getDeploymentObject() err: %v", err)
+	}
+
+	esc.Spec.ApplicationConfig.Replicas = nil
+	desired, err := r.getDeploymentObject(webhookDeploymentAssetName, esc, controllerDefaultResourceLabels)
+	if err != nil {
+		t.Fatalf("getDeploymentObject() err: %v", err)
+	}
+	// fields set by the operator which are absent from the applied configuration are removed by server-side apply.
+	if ptr.Deref(desired.Spec.Replicas, 0) != 1 {
+		t.Errorf("getDeploymentObject() replicas: %v, want: 1", desired.Spec.Replicas)
+	}
+	if desired.Spec.Template.Spec.Affinity != nil {
+		t.Errorf("getDeploymentObject() high availability affinity must not be set for single replica: %+v", desired.Spec.Template.Spec.Affinity)
+	}
+	if len(desired.Spec.Template.Spec.TopologySpreadConstraints) != 0 || len(fetched.Spec.Template.Spec.TopologySpreadConstraints) == 0 {
+		t.Errorf("getDeploymentObject() topology spread constraints: %+v, want set only for multiple replicas", desired.Spec.Template.Spec.TopologySpreadConstraints)
+	}
+}
+
+func TestDeploymentComponentConfigOverrides(t *testing.T) {
+	appResources := &corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+	}
+	webhookResources := &corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+	}
+	appTolerations := []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "external-secrets", Effect: corev1.TaintEffectNoSchedule}}
+	componentTolerations := []corev1.Toleration{{Key: "node-role.kubernetes.io/infra", Operator: corev1.TolerationOpExists}}
+	webhookSpread := []corev1.TopologySpreadConstraint{
+		{MaxSkew: 1, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.DoNotSchedule},
+	}
+
+	tests := []struct {
+		name       string
+		assetName  string
+		components map[v1alpha1.ComponentName]v1alpha1.ComponentConfig
+		validate   func(*testing.T, *appsv1.Deployment)
+		wantErr    string
+	}{
+		{
+			name:      "appConfig is used when component is not configured",
+			assetName: webhookDeploymentAssetName,
+			components: map[v1alpha1.ComponentName]v1alpha1.ComponentConfig{
+				v1alpha1.CoreController: {Resources: webhookResources},
+			},
+			validate: func(t *testing.T, d *appsv1.Deployment) {
+				if got := d.Spec.Template.Spec.Containers[0].Resources; !reflect.DeepEqual(got, *appResources) {
+					t.Errorf("resources: %+v, want: %+v", got, appResources)
+				}
+				if got := d.Spec.Template.Spec.Tolerations; !reflect.DeepEqual(got, appTolerations) {
+					t.Errorf("tolerations: %+v, want: %+v", got, appTolerations)
+				}
+			},
+		},
+		{
+			name:      "component configuration takes precedence over appConfig",
+			assetName: webhookDeploymentAssetName,
+			components: map[v1alpha1.ComponentName]v1alpha1.ComponentConfig{
+				v1alpha1.Webhook: {
+					Resources:                 webhookResources,
+					Tolerations:               componentTolerations,
+					NodeSelector:              map[string]string{"node-role.kubernetes.io/infra": ""},
+					PriorityClassName:         "system-cluster-critical",
+					TopologySpreadConstraints: webhookSpread,
+				},
+			},
+			validate: func(t *testing.T, d *appsv1.Deployment) {
+				spec := d.Spec.Template.Spec
+				if got := spec.Containers[0].Resources; !reflect.DeepEqual(got, *webhookResources) {
+					t.Errorf("resources: %+v, want: %+v", got, webhookResources)
+				}
+				if !reflect.DeepEqual(spec.Tolerations, componentTolerations) {
+					t.Errorf("tolerations: %+v, want: %+v", spec.Tolerations, componentTolerations)
+				}
+				if !reflect.DeepEqual(spec.NodeSelector, map[string]string{"node-role.kubernetes.io/infra": ""}) {
+					t.Errorf("nodeSelector: %+v", spec.NodeSelector)
+				}
+				if spec.PriorityClassName != "system-cluster-critical" {
+					t.Errorf("priorityClassName: %q", spec.PriorityClassName)
+				}
+				if !reflect.DeepEqual(spec.TopologySpreadConstraints, webhookSpread) {
+					t.Errorf("topologySpreadConstraints: %+v, want: %+v", spec.TopologySpreadConstraints, webhookSpread)
+				}
+			},
+		},
+		{
+			name:      "cert-controller component configuration",
+			assetName: certControllerDeploymentAssetName,
+			components: map[v1alpha1.ComponentName]v1alpha1.ComponentConfig{
+				v1alpha1.CertController: {PriorityClassName: "cert-controller-priority"},
+			},
+			validate: func(t *testing.T, d *appsv1.Deployment) {
+				if got := d.Spec.Template.Spec.PriorityClassName; got != "cert-controller-priority" {
+					t.Errorf("priorityClassName: %q", got)
+				}
+			},
+		},
+		{
+			name:      "invalid component priority class name",
+			assetName: controllerDeploymentAssetName,
+			components: map[v1alpha1.ComponentName]v1alpha1.ComponentConfig{
+				v1alpha1.CoreController: {PriorityClassName: "Invalid_Name"},
+			},
+			wantErr: `failed to update priority class name: spec.appConfig.components[ExternalSecretsCoreController].priorityClassName: Invalid value: "Invalid_Name": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+		},
+		{
+			name:      "invalid component topology spread constraints",
+			assetName: webhookDeploymentAssetName,
+			components: map[v1alpha1.ComponentName]v1alpha1.ComponentConfig{
+				v1alpha1.Webhook: {TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+					{MaxSkew: 0, TopologyKey: corev1.LabelHostname, WhenUnsatisfiable: corev1.ScheduleAnyway},
+				}},
+			},
+			wantErr: "failed to update topology spread constraints: spec.appConfig.components[Webhook].topologySpreadConstraints[0].maxSkew: Invalid value: 0: must be greater than zero",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			t.Setenv("RELATED_IMAGE_EXTERNAL_SECRETS", commontest.TestExternalSecretsImageName)
+			t.Setenv("RELATED_IMAGE_BITWARDEN_SDK_SERVER", commontest.TestBitwardenImageName)
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ApplicationConfig.Resources = appResources
+			esc.Spec.ApplicationConfig.Tolerations = appTolerations
+			esc.Spec.ApplicationConfig.Components = tt.components
+
+			deployment, err := r.getDeploymentObject(tt.assetName, esc, controllerDefaultResourceLabels)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("getDeploymentObject() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if tt.validate != nil {
+				tt.validate(t, deployment)
+			}
+		})
+	}
+}
+
+func TestCoreControllerTuningArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *v1alpha1.CoreControllerConfig
+		wantArgs []string
+		skipArgs []string
+	}{
+		{
+			name:     "controller config not provided",
+			wantArgs: []string{"--concurrent=1"},
+			skipArgs: []string{"--store-requeue-interval", "--client-qps", "--client-burst", "--enable-secrets-caching", "--enable-configmaps-caching"},
+		},
+		{
+			name: "controller config with all the flags",
+			config: &v1alpha1.CoreControllerConfig{
+				Concurrent:              8,
+				StoreRequeueInterval:    &metav1.Duration{Duration: 10 * time.Minute},
+				ClientQPS:               200,
+				ClientBurst:             400,
+				EnableSecretsCaching:    v1alpha1.Enabled,
+				EnableConfigMapsCaching: v1alpha1.Disabled,
+			},
+			wantArgs: []string{
+				"--concurrent=8",
+				"--store-requeue-interval=10m0s",
+				"--client-qps=200",
+				"--client-burst=400",
+				"--enable-secrets-caching=true",
+				"--enable-configmaps-caching=false",
+			},
+		},
+		{
+			name:     "controller config with only concurrency",
+			config:   &v1alpha1.CoreControllerConfig{Concurrent: 4},
+			wantArgs: []string{"--concurrent=4"},
+			skipArgs: []string{"--store-requeue-interval", "--client-qps", "--client-burst"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			t.Setenv("RELATED_IMAGE_EXTERNAL_SECRETS", commontest.TestExternalSecretsImageName)
+			t.Setenv("RELATED_IMAGE_BITWARDEN_SDK_SERVER", commontest.TestBitwardenImageName)
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ApplicationConfig.Controller = tt.config
+
+			deployment, err := r.getDeploymentObject(controllerDeploymentAssetName, esc, controllerDefaultResourceLabels)
+			if err != nil {
+				t.Fatalf("getDeploymentObject() err: %v", err)
+			}
+			args := deployment.Spec.Template.Spec.Containers[0].Args
+			for _, want := range tt.wantArgs {
+				if !slices.Contains(args, want) {
+					t.Errorf("getDeploymentObject() args: %v, missing: %s", args, want)
+				}
+			}
+			for _, skip := range tt.skipArgs {
+				if slices.ContainsFunc(args, func(arg string) bool { return strings.HasPrefix(arg, skip+"=") }) {
+					t.Errorf("getDeploymentObject() args: %v, must not have: %s", args, skip)
+				}
+			}
+		})
+	}
+}
+
+func TestDeploymentsWithCustomNamespace(t *testing.T) {
+	r := testReconciler(t)
+	t.Setenv("RELATED_IMAGE_EXTERNAL_SECRETS", commontest.TestExternalSecretsImageName)
+	t.Setenv("RELATED_IMAGE_BITWARDEN_SDK_SERVER", commontest.TestBitwardenImageName)
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ApplicationConfig.Namespace = "test-eso"
+
+	wantArgs := map[string][]string{
+		webhookDeploymentAssetName:        {"--dns-name=external-secrets-webhook.test-eso.svc"},
+		certControllerDeploymentAssetName: {"--service-namespace=test-eso", "--secret-namespace=test-eso"},
+	}
+	for _, assetName := range getDeploymentAssetNames(esc) {
+		deployment, err := r.getDeploymentObject(assetName, esc, controllerDefaultResourceLabels)
+		if err != nil {
+			t.Fatalf("getDeploymentObject() err: %v", err)
+		}
+		if deployment.GetNamespace() != "test-eso" {
+			t.Errorf("getDeploymentObject() %s namespace: %q", deployment.GetName(), deployment.GetNamespace())
+		}
+		args := deployment.Spec.Template.Spec.Containers[0].Args
+		for _, want := range wantArgs[assetName] {
+			if !slices.Contains(args, want) {
+				t.Errorf("getDeploymentObject() %s args: %v, missing: %s", deployment.GetName(), args, want)
+			}
+		}
+	}
+}
+
+func TestDeploymentsWithServiceCA(t *testing.T) {
+	r := testReconciler(t)
+	t.Setenv("RELATED_IMAGE_EXTERNAL_SECRETS", commontest.TestExternalSecretsImageName)
+	t.Setenv("RELATED_IMAGE_BITWARDEN_SDK_SERVER", commontest.TestBitwardenImageName)
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ControllerConfig.CertProvider = &v1alpha1.CertProvidersConfig{
+		ServiceCA: &v1alpha1.ServiceCAConfig{
+			Mode: v1alpha1.Enabled,
+		},
+	}
+	esc.Spec.Plugins.BitwardenSecretManagerProvider = &v1alpha1.BitwardenSecretManagerProvider{
+		Mode: v1alpha1.Enabled,
+	}
+
+	assetNames := getDeploymentAssetNames(esc)
+	if slices.Contains(assetNames, certControllerDeploymentAssetName) {
+		t.Errorf("getDeploymentAssetNames() %v, cert-controller must not be deployed with service-ca", assetNames)
+	}
+
+	wantVolumes := map[string]struct {
+		volumeName string
+		secretName string
+		paths      []string
+	}{
+		webhookDeploymentAssetName:   {volumeName: "certs", secretName: serviceCATLSSecretWebhook, paths: []string{"tls.crt", "tls.key", "ca.crt"}},
+		bitwardenDeploymentAssetName: {volumeName: "bitwarden-tls-certs", secretName: serviceCATLSSecretBitwarden, paths: []string{"cert.pem", "key.pem", "ca.pem"}},
+	}
+	for assetName, want := range wantVolumes {
+		deployment, err := r.getDeploymentObject(assetName, esc, controllerDefaultResourceLabels)
+		if err != nil {
+			t.Fatalf("getDeploymentObject() err: %v", err)
+		}
+		var projected *corev1.ProjectedVolumeSource
+		for _, volume := range deployment.Spec.Template.Spec.Volumes {
+			if volume.Name == want.volumeName {
+				if volume.Secret != nil {
+					t.Errorf("getDeploymentObject() %s volume %s has secret source along with projected", deployment.GetName(), volume.Name)
+				}
+				projected = volume.Projected
+			}
+		}
+		if projected == nil {
+			t.Fatalf("getDeploymentObject() %s volume %s is not projected", deployment.GetName(), want.volumeName)
+		}
+
+		var paths []string
+		for _, source := range projected.Sources {
+			switch {
+			case source.Secret != nil:
+				if source.Secret.Name != want.secretName {
+					t.Errorf("getDeploymentObject() %s projected secret: %q, want: %q", deployment.GetName(), source.Secret.Name, want.secretName)
+				}
+				for _, item := range source.Secret.Items {
+					paths = append(paths, item.Path)
+				}
+			case source.ConfigMap != nil:
+				if source.ConfigMap.Name != "external-secrets-service-ca-bundle" {
+					t.Errorf("getDeploymentObject() %s projected configmap: %q", deployment.GetName(), source.ConfigMap.Name)
+				}
+				for _, item := range source.ConfigMap.Items {
+					paths = append(paths, item.Path)
+				}
+			}
+		}
+		if !reflect.DeepEqual(paths, want.paths) {
+			t.Errorf("getDeploymentObject() %s projected paths: %v, want: %v", deployment.GetName(), paths, want.paths)
+		}
+	}
+}
+
+func TestDeploymentsWithWebhookCertificateSecretRef(t *testing.T) {
+	r := testReconciler(t)
+	t.Setenv("RELATED_IMAGE_EXTERNAL_SECRETS", commontest.TestExternalSecretsImageName)
+	t.Setenv("RELATED_IMAGE_BITWARDEN_SDK_SERVER", commontest.TestBitwardenImageName)
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ApplicationConfig.WebhookConfig = &v1alpha1.WebhookConfig{
+		CertificateSecretRef: &v1alpha1.SecretReference{Name: "webhook-tls"},
+	}
+
+	assetNames := getDeploymentAssetNames(esc)
+	if slices.Contains(assetNames, certControllerDeploymentAssetName) {
+		t.Errorf("getDeploymentAssetNames() %v, cert-controller must not be deployed with webhook certificate secret", assetNames)
+	}
+
+	deployment, err := r.getDeploymentObject(webhookDeploymentAssetName, esc, controllerDefaultResourceLabels)
+	if err != nil {
+		t.Fatalf("getDeploymentObject() err: %v", err)
+	}
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name != "certs" {
+			continue
+		}
+		if volume.Secret == nil || volume.Secret.SecretName != "webhook-tls" {
+			t.Errorf("getDeploymentObject() certs volume: %+v, want secret webhook-tls", volume.VolumeSource)
+		}
+		return
+	}
+	t.Errorf("getDeploymentObject() %s has no certs volume", deployment.GetName())
+}
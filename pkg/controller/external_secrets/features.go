@@ -0,0 +1,114 @@
+package external_secrets
+
+import (
+	"slices"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+)
+
+const (
+	externalSecretsAPIGroup = "external-secrets.io"
+	generatorsAPIGroup      = "generators.external-secrets.io"
+
+	// generatorStatesResource is the resource used by the external-secrets controller for tracking the
+	// generated values for garbage collection, and is required even when the generators are disabled.
+	generatorStatesResource = "generatorstates"
+)
+
+// getFeatures returns the configured reconciler toggles, and is empty when not configured.
+func getFeatures(esc *operatorv1alpha1.ExternalSecretsConfig) operatorv1alpha1.FeaturesConfig {
+	if esc.Spec.ApplicationConfig.Features == nil {
+		return operatorv1alpha1.FeaturesConfig{}
+	}
+	return *esc.Spec.ApplicationConfig.Features
+}
+
+// isFeatureEnabled returns whether the feature is enabled, which is the case unless explicitly disabled.
+func isFeatureEnabled(mode operatorv1alpha1.Mode) bool {
+	return mode != operatorv1alpha1.Disabled
+}
+
+func isPushSecretEnabled(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	return isFeatureEnabled(getFeatures(esc).PushSecret)
+}
+
+// isClusterPushSecretEnabled returns whether ClusterPushSecret is enabled, which requires PushSecret to be
+// enabled for reconciling the PushSecrets created for it in each of the namespaces.
+func isClusterPushSecretEnabled(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	return getOperatingNamespace(esc) == "" && isPushSecretEnabled(esc) &&
+		isFeatureEnabled(getFeatures(esc).ClusterPushSecret)
+}
+
+func isClusterExternalSecretEnabled(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	return getOperatingNamespace(esc) == "" && isFeatureEnabled(getFeatures(esc).ClusterExternalSecret)
+}
+
+func isClusterSecretStoreEnabled(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	return getOperatingNamespace(esc) == "" && isFeatureEnabled(getFeatures(esc).ClusterSecretStore)
+}
+
+// isGeneratorsEnabled is used only for the RBAC, since external-secrets does not have an option for disabling
+// the generators, and removeDisabledFeatureRules revokes the access to the generators when disabled.
+func isGeneratorsEnabled(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	return isFeatureEnabled(getFeatures(esc).Generators)
+}
+
+// getDisabledFeatureResources returns the external-secrets.io resources of the disabled reconcilers. The resources
+// of the cluster scoped reconcilers implicitly disabled by operatingNamespace are not included, for the users to
+// continue to have access to the existing resources.
+func getDisabledFeatureResources(esc *operatorv1alpha1.ExternalSecretsConfig) []string {
+	features := getFeatures(esc)
+	var resources []string
+	if !isFeatureEnabled(features.PushSecret) {
+		resources = append(resources, "pushsecrets")
+	}
+	if !isFeatureEnabled(features.PushSecret) || !isFeatureEnabled(features.ClusterPushSecret) {
+		resources = append(resources, "clusterpushsecrets")
+	}
+	if !isFeatureEnabled(features.ClusterExternalSecret) {
+		resources = append(resources, "clusterexternalsecrets")
+	}
+	if !isFeatureEnabled(features.ClusterSecretStore) {
+		resources = append(resources, "clustersecretstores")
+	}
+	return resources
+}
+
+// removeDisabledFeatureRules removes the resources of the disabled reconcilers, along with their subresources,
+// from the ClusterRole rules, and removes the rules left without any resources.
+func removeDisabledFeatureRules(clusterRole *rbacv1.ClusterRole, esc *operatorv1alpha1.ExternalSecretsConfig) {
+	disabled := getDisabledFeatureResources(esc)
+	generatorsEnabled := isGeneratorsEnabled(esc)
+	if len(disabled) == 0 && generatorsEnabled {
+		return
+	}
+
+	isDisabled := func(apiGroup, resource string) bool {
+		name, _, _ := strings.Cut(resource, "/")
+		switch apiGroup {
+		case externalSecretsAPIGroup:
+			return slices.Contains(disabled, name)
+		case generatorsAPIGroup:
+			return !generatorsEnabled && name != generatorStatesResource
+		}
+		return false
+	}
+
+	rules := make([]rbacv1.PolicyRule, 0, len(clusterRole.Rules))
+	for _, rule := range clusterRole.Rules {
+		if len(rule.APIGroups) != 1 {
+			rules = append(rules, rule)
+			continue
+		}
+		rule.Resources = slices.DeleteFunc(slices.Clone(rule.Resources), func(resource string) bool {
+			return isDisabled(rule.APIGroups[0], resource)
+		})
+		if len(rule.Resources) != 0 {
+			rules = append(rules, rule)
+		}
+	}
+	clusterRole.Rules = rules
+}
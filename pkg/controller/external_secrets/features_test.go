@@ -0,0 +1,159 @@
+package external_secrets
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+)
+
+// clusterRoleResources returns the resources granted in the ClusterRole rules, prefixed with the API group.
+func clusterRoleResources(clusterRole *rbacv1.ClusterRole) []string {
+	var resources []string
+	for _, rule := range clusterRole.Rules {
+		for _, group := range rule.APIGroups {
+			for _, resource := range rule.Resources {
+				resources = append(resources, group+"/"+resource)
+			}
+		}
+	}
+	return resources
+}
+
+func TestRemoveDisabledFeatureRules(t *testing.T) {
+	tests := []struct {
+		name              string
+		features          *operatorv1alpha1.FeaturesConfig
+		operatingNS       string
+		wantRemoved       []string
+		wantRetained      []string
+		wantRulesRetained bool
+	}{
+		{
+			name:              "features not configured",
+			wantRetained:      []string{"external-secrets.io/pushsecrets", "external-secrets.io/clustersecretstores", "generators.external-secrets.io/passwords"},
+			wantRulesRetained: true,
+		},
+		{
+			name:         "push secret disabled",
+			features:     &operatorv1alpha1.FeaturesConfig{PushSecret: operatorv1alpha1.Disabled},
+			wantRemoved:  []string{"external-secrets.io/pushsecrets", "external-secrets.io/pushsecrets/status", "external-secrets.io/clusterpushsecrets"},
+			wantRetained: []string{"external-secrets.io/externalsecrets", "external-secrets.io/clustersecretstores"},
+		},
+		{
+			name: "cluster external secret and cluster secret store disabled",
+			features: &operatorv1alpha1.FeaturesConfig{
+				ClusterExternalSecret: operatorv1alpha1.Disabled,
+				ClusterSecretStore:    operatorv1alpha1.Disabled,
+			},
+			wantRemoved:  []string{"external-secrets.io/clusterexternalsecrets", "external-secrets.io/clustersecretstores/finalizers"},
+			wantRetained: []string{"external-secrets.io/pushsecrets", "external-secrets.io/clusterpushsecrets"},
+		},
+		{
+			name:         "generators disabled",
+			features:     &operatorv1alpha1.FeaturesConfig{Generators: operatorv1alpha1.Disabled},
+			wantRemoved:  []string{"generators.external-secrets.io/passwords", "generators.external-secrets.io/clustergenerators"},
+			wantRetained: []string{"generators.external-secrets.io/generatorstates"},
+		},
+		{
+			name:              "cluster scoped resources retained with operating namespace",
+			operatingNS:       "test-ns",
+			wantRetained:      []string{"external-secrets.io/clustersecretstores", "external-secrets.io/clusterexternalsecrets"},
+			wantRulesRetained: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ApplicationConfig.Features = tt.features
+			esc.Spec.ApplicationConfig.OperatingNamespace = tt.operatingNS
+
+			clusterRole := testReconciler(t).getClusterRoleObject(controllerClusterRoleAssetName, controllerDefaultResourceLabels)
+			want := len(clusterRole.Rules)
+			removeDisabledFeatureRules(clusterRole, esc)
+
+			resources := clusterRoleResources(clusterRole)
+			for _, r := range tt.wantRemoved {
+				if slices.Contains(resources, r) {
+					t.Errorf("removeDisabledFeatureRules() %s must be removed, got: %v", r, resources)
+				}
+			}
+			for _, r := range tt.wantRetained {
+				if !slices.Contains(resources, r) {
+					t.Errorf("removeDisabledFeatureRules() %s must be retained, got: %v", r, resources)
+				}
+			}
+			if tt.wantRulesRetained && len(clusterRole.Rules) != want {
+				t.Errorf("removeDisabledFeatureRules() rules: %d, want: %d", len(clusterRole.Rules), want)
+			}
+			for _, rule := range clusterRole.Rules {
+				if len(rule.Resources) == 0 {
+					t.Errorf("removeDisabledFeatureRules() rule without resources must be removed: %+v", rule)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcilerToggleArgs(t *testing.T) {
+	tests := []struct {
+		name        string
+		features    *operatorv1alpha1.FeaturesConfig
+		operatingNS string
+		wantArgs    []string
+	}{
+		{
+			name: "all reconcilers enabled by default",
+			wantArgs: []string{
+				"--enable-push-secret-reconciler=true",
+				"--enable-cluster-store-reconciler=true",
+				"--enable-cluster-external-secret-reconciler=true",
+				"--enable-cluster-push-secret-reconciler=true",
+			},
+		},
+		{
+			name:     "push secret disabled implicitly disables cluster push secret",
+			features: &operatorv1alpha1.FeaturesConfig{PushSecret: operatorv1alpha1.Disabled, ClusterPushSecret: operatorv1alpha1.Enabled},
+			wantArgs: []string{
+				"--enable-push-secret-reconciler=false",
+				"--enable-cluster-store-reconciler=true",
+				"--enable-cluster-push-secret-reconciler=false",
+			},
+		},
+		{
+			name:        "operating namespace disables cluster scoped reconcilers",
+			features:    &operatorv1alpha1.FeaturesConfig{ClusterSecretStore: operatorv1alpha1.Enabled},
+			operatingNS: "test-ns",
+			wantArgs: []string{
+				"--namespace=test-ns",
+				"--enable-push-secret-reconciler=true",
+				"--enable-cluster-store-reconciler=false",
+				"--enable-cluster-external-secret-reconciler=false",
+				"--enable-cluster-push-secret-reconciler=false",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ApplicationConfig.Features = tt.features
+			esc.Spec.ApplicationConfig.OperatingNamespace = tt.operatingNS
+			deployment := testDeployment(controllerDeploymentAssetName)
+
+			updateContainerSpec(deployment, esc, commontest.TestExternalSecretsImageName, "info")
+
+			args := deployment.Spec.Template.Spec.Containers[0].Args
+			for _, want := range tt.wantArgs {
+				if !slices.Contains(args, want) {
+					t.Errorf("updateContainerSpec() args: %s, missing: %s", strings.Join(args, " "), want)
+				}
+			}
+		})
+	}
+}
@@ -5,8 +5,12 @@ import (
 	"regexp"
 
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/common"
@@ -22,7 +26,7 @@ var (
 
 func (r *Reconciler) reconcileExternalSecretsDeployment(esc *operatorv1alpha1.ExternalSecretsConfig, recon bool) error {
 	if err := r.validateExternalSecretsConfig(esc); err != nil {
-		return common.NewIrrecoverableError(err, "%s/%s configuration validation failed", esc.GetObjectKind().GroupVersionKind().String(), esc.GetName())
+		return common.NewIrrecoverableError(err, "%s/%s configuration validation failed", esc.GetObjectKind().GroupVersionKind().String(), esc.GetName()).WithCause(common.InvalidConfiguration)
 	}
 
 	// if user has set custom labels to be added to all resources created by the controller
@@ -52,12 +56,21 @@ func (r *Reconciler) reconcileExternalSecretsDeployment(esc *operatorv1alpha1.Ex
 		resourceLabels[k] = v
 	}
 
+	r.inventory = make(resourceInventory)
+
 	if err := r.createOrApplyNamespace(esc, resourceLabels); err != nil {
 		r.log.Error(err, "failed to create namespace")
 		return err
 	}
 
-	if err := r.createOrApplyNetworkPolicies(esc, resourceLabels, recon); err != nil {
+	if err := r.reconcileCertificateMigration(esc); err != nil {
+		r.log.Error(err, "failed to reconcile webhook certificate migration")
+		return err
+	}
+
+	err := r.createOrApplyNetworkPolicies(esc, resourceLabels, recon)
+	apimeta.SetStatusCondition(&esc.Status.Conditions, getNetworkPoliciesAppliedCondition(esc, err))
+	if err != nil {
 		r.log.Error(err, "failed to reconcile network policy resource")
 		return err
 	}
@@ -77,6 +90,16 @@ func (r *Reconciler) reconcileExternalSecretsDeployment(esc *operatorv1alpha1.Ex
 		return err
 	}
 
+	if err := r.createOrApplyTrustedCABundleConfigMap(esc, resourceLabels, recon); err != nil {
+		r.log.Error(err, "failed to reconcile trusted CA bundle configmap resource")
+		return err
+	}
+
+	if err := r.createOrApplyServiceCABundleConfigMap(esc, resourceLabels, recon); err != nil {
+		r.log.Error(err, "failed to reconcile service CA bundle configmap resource")
+		return err
+	}
+
 	if err := r.createOrApplyRBACResource(esc, resourceLabels, recon); err != nil {
 		r.log.Error(err, "failed to reconcile rbac resources")
 		return err
@@ -87,16 +110,35 @@ func (r *Reconciler) reconcileExternalSecretsDeployment(esc *operatorv1alpha1.Ex
 		return err
 	}
 
+	if err := r.createOrApplyMonitoring(esc, resourceLabels, recon); err != nil {
+		r.log.Error(err, "failed to reconcile monitoring resources")
+		return err
+	}
+
 	if err := r.createOrApplyDeployments(esc, resourceLabels, recon); err != nil {
 		r.log.Error(err, "failed to reconcile deployment resource")
 		return err
 	}
 
+	if err := r.createOrApplyPodDisruptionBudgets(esc, resourceLabels, recon); err != nil {
+		r.log.Error(err, "failed to reconcile poddisruptionbudget resource")
+		return err
+	}
+
 	if err := r.createOrApplyValidatingWebhookConfiguration(esc, resourceLabels, recon); err != nil {
 		r.log.Error(err, "failed to reconcile validating webhook resource")
 		return err
 	}
 
+	// resources of the previous certificate provider are required by the webhook, until
+	// it is switched to the certificate of the new provider.
+	if isCertificateMigrationProvisioning(esc) {
+		r.log.V(1).Info("webhook certificate migration in progress, skipping pruning of resources no longer required")
+	} else if err := r.pruneExternalSecretsResources(esc); err != nil {
+		r.log.Error(err, "failed to prune resources no longer required")
+		return err
+	}
+
 	if addProcessedAnnotation(esc) {
 		if err := r.UpdateWithRetry(r.ctx, esc); err != nil {
 			return fmt.Errorf("failed to update processed annotation to %s: %w", esc.GetName(), err)
@@ -108,21 +150,100 @@ func (r *Reconciler) reconcileExternalSecretsDeployment(esc *operatorv1alpha1.Ex
 }
 
 // createOrApplyNamespace is for the creating the namespace in which the `external-secrets`
-// resources will be created.
+// resources will be created, and for reconciling the labels and annotations configured for it.
 func (r *Reconciler) createOrApplyNamespace(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string) error {
-	namespace := getNamespace(esc)
-	obj := &corev1.Namespace{
+	desired, err := r.getNamespaceObject(esc, resourceLabels)
+	if err != nil {
+		return common.NewIrrecoverableError(err, "invalid namespace configuration")
+	}
+
+	namespaceName := desired.GetName()
+	r.log.V(4).Info("reconciling namespace resource", "name", namespaceName)
+	if err := setDesiredStateHash(desired); err != nil {
+		return common.NewIrrecoverableError(err, "failed to compute desired state hash of %s namespace resource", namespaceName)
+	}
+	fetched := &corev1.Namespace{}
+	exist, err := r.Exists(r.ctx, client.ObjectKeyFromObject(desired), fetched)
+	if err == nil && !exist {
+		// namespace created by other actors, like the user or OLM, will not have the labels
+		// used for selecting the objects to cache, and must be read directly.
+		exist, err = r.UncachedClient.Exists(r.ctx, client.ObjectKeyFromObject(desired), fetched)
+	}
+	if err != nil {
+		return common.FromClientError(err, "failed to check %s namespace resource already exists", namespaceName)
+	}
+	if exist && r.isDriftCorrectionDisabled(fetched, "namespace") {
+		return nil
+	}
+	if exist && r.isDesiredStateApplied(desired, fetched) {
+		r.log.V(4).Info("namespace desired state is unchanged since last applied", "name", namespaceName)
+		return nil
+	}
+
+	if exist {
+		if err := r.UpgradeManagedFields(r.ctx, fetched); err != nil {
+			return common.FromClientError(err, "failed to migrate managed fields of %s namespace resource", namespaceName)
+		}
+	}
+	// only the labels and annotations configured are owned by the operator, and the
+	// metadata added by the other actors is retained.
+	if err := r.Apply(r.ctx, desired); err != nil {
+		return common.FromClientError(err, "failed to apply %s namespace resource", namespaceName)
+	}
+	r.recordAppliedState(desired)
+	switch {
+	case !exist:
+		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "namespace resource %s created", namespaceName)
+	case desired.GetResourceVersion() != fetched.GetResourceVersion():
+		r.log.V(1).Info("namespace has been modified, updated to desired state", "name", namespaceName)
+		recordDriftCorrection("namespace")
+		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "namespace resource %s reconciled back to desired state", namespaceName)
+	default:
+		r.log.V(4).Info("namespace resource already exists and is in expected state", "name", namespaceName)
+	}
+	return nil
+}
+
+// getNamespaceObject returns the namespace object with the labels and annotations configured
+// in `spec.controllerConfig.namespace`, in addition to the labels added to all the resources.
+func (r *Reconciler) getNamespaceObject(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string) (*corev1.Namespace, error) {
+	namespace := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: namespace,
+			Name:   getNamespace(esc),
+			Labels: make(map[string]string, len(resourceLabels)),
 		},
 	}
+	for k, v := range resourceLabels {
+		namespace.Labels[k] = v
+	}
+
+	config := esc.Spec.ControllerConfig.Namespace
+	if config == nil {
+		return namespace, nil
+	}
+
+	if err := validateNamespaceConfig(config, field.NewPath("spec", "controllerConfig", "namespace")).ToAggregate(); err != nil {
+		return nil, err
+	}
 
-	if err := r.Create(r.ctx, obj); err != nil {
-		if errors.IsAlreadyExists(err) {
-			r.log.V(4).Info("namespace already exists", "namespace", namespace)
-			return nil
+	for k, v := range config.Labels {
+		if disallowedLabelMatcher.MatchString(k) {
+			r.log.V(1).Info("skip adding unallowed namespace label configured in externalsecretsconfig.operator.openshift.io", "label", k, "value", v)
+			continue
 		}
-		return fmt.Errorf("failed to create %s namespace: %w", namespace, err)
+		namespace.Labels[k] = v
 	}
-	return nil
+	if len(config.Annotations) != 0 {
+		namespace.Annotations = make(map[string]string, len(config.Annotations))
+		for k, v := range config.Annotations {
+			namespace.Annotations[k] = v
+		}
+	}
+	return namespace, nil
+}
+
+// validateNamespaceConfig validates the labels and annotations configured for the operand namespace.
+func validateNamespaceConfig(config *operatorv1alpha1.NamespaceConfig, fldPath *field.Path) field.ErrorList {
+	errs := metav1validation.ValidateLabels(config.Labels, fldPath.Child("labels"))
+	return append(errs, apivalidation.ValidateAnnotations(config.Annotations, fldPath.Child("annotations"))...)
 }
@@ -0,0 +1,234 @@
+package external_secrets
+
+import (
+	"context"
+	"maps"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+)
+
+func TestCreateOrApplyNamespace(t *testing.T) {
+	namespaceConfig := &operatorv1alpha1.NamespaceConfig{
+		Labels: map[string]string{
+			"openshift.io/cluster-monitoring":    "true",
+			"pod-security.kubernetes.io/enforce": "restricted",
+		},
+		Annotations: map[string]string{
+			"openshift.io/node-selector": "node-role.kubernetes.io/infra=",
+		},
+	}
+
+	tests := []struct {
+		name            string
+		namespaceConfig *operatorv1alpha1.NamespaceConfig
+		preReq          func(*Reconciler, *fakes.FakeCtrlClient)
+		wantApplied     bool
+		wantEvent       string
+		wantErr         string
+	}{
+		{
+			name:            "namespace created with configured labels and annotations",
+			namespaceConfig: namespaceConfig,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, nil)
+			},
+			wantApplied: true,
+			wantEvent:   "Normal Reconciled namespace resource external-secrets created",
+		},
+		{
+			name:            "namespace in desired state",
+			namespaceConfig: namespaceConfig,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+					ns := testNamespace(namespaceConfig)
+					ns.SetResourceVersion("1")
+					ns.DeepCopyInto(obj.(*corev1.Namespace))
+					return true, nil
+				})
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					obj.SetResourceVersion("1")
+					return nil
+				})
+			},
+			wantApplied: true,
+		},
+		{
+			name:            "namespace unchanged since last applied",
+			namespaceConfig: namespaceConfig,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				esc := commontest.TestExternalSecretsConfig()
+				esc.Spec.ControllerConfig.Namespace = namespaceConfig
+				applied, _ := r.getNamespaceObject(esc, controllerDefaultResourceLabels)
+				_ = setDesiredStateHash(applied)
+				applied.SetResourceVersion("1")
+				r.recordAppliedState(applied)
+				m.ExistsCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+					applied.DeepCopyInto(obj.(*corev1.Namespace))
+					return true, nil
+				})
+			},
+		},
+		{
+			name:            "namespace labels and annotations reconciled back to desired state",
+			namespaceConfig: namespaceConfig,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+					ns := testNamespace(namespaceConfig)
+					ns.Labels["pod-security.kubernetes.io/enforce"] = "privileged"
+					ns.SetResourceVersion("1")
+					ns.DeepCopyInto(obj.(*corev1.Namespace))
+					return true, nil
+				})
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					obj.SetResourceVersion("2")
+					return nil
+				})
+			},
+			wantApplied: true,
+			wantEvent:   "Normal Reconciled namespace resource external-secrets reconciled back to desired state",
+		},
+		{
+			name:            "namespace created by other actors is updated",
+			namespaceConfig: namespaceConfig,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, nil)
+				uncached := &fakes.FakeCtrlClient{}
+				uncached.ExistsCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+					obj.SetName(key.Name)
+					obj.SetResourceVersion("1")
+					return true, nil
+				})
+				r.UncachedClient = uncached
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					obj.SetResourceVersion("2")
+					return nil
+				})
+			},
+			wantApplied: true,
+			wantEvent:   "Normal Reconciled namespace resource external-secrets reconciled back to desired state",
+		},
+		{
+			name:            "namespace with drift correction disabled",
+			namespaceConfig: namespaceConfig,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+					obj.SetName(key.Name)
+					obj.SetAnnotations(map[string]string{driftCorrectionDisabledAnnotation: "true"})
+					return true, nil
+				})
+			},
+		},
+		{
+			name: "namespace label with invalid value",
+			namespaceConfig: &operatorv1alpha1.NamespaceConfig{
+				Labels: map[string]string{"openshift.io/cluster-monitoring": "true?"},
+			},
+			wantErr: `invalid namespace configuration: spec.controllerConfig.namespace.labels: Invalid value: "true?": a valid label must be an empty string or consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyValue',  or 'my_value',  or '12345', regex used for validation is '(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?')`,
+		},
+		{
+			name: "namespace annotation with invalid key",
+			namespaceConfig: &operatorv1alpha1.NamespaceConfig{
+				Annotations: map[string]string{"openshift.io/node-selector/": ""},
+			},
+			wantErr: `invalid namespace configuration: spec.controllerConfig.namespace.annotations: Invalid value: "openshift.io/node-selector/": a qualified name must consist of alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character (e.g. 'MyName',  or 'my.name',  or '123-abc', regex used for validation is '([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]') with an optional DNS subdomain prefix and '/' (e.g. 'example.com/MyName')`,
+		},
+		{
+			name: "namespace fetch fails",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, commontest.TestClientError)
+			},
+			wantErr: "failed to check external-secrets namespace resource already exists: test client error",
+		},
+		{
+			name: "namespace apply fails",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(true, nil)
+				m.ApplyReturns(commontest.TestClientError)
+			},
+			wantErr: "failed to apply external-secrets namespace resource: test client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			r.UncachedClient = mock
+			if tt.preReq != nil {
+				tt.preReq(r, mock)
+			}
+
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ControllerConfig.Namespace = tt.namespaceConfig
+
+			err := r.createOrApplyNamespace(esc, controllerDefaultResourceLabels)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("createOrApplyNamespace() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if tt.wantErr != "" {
+				return
+			}
+
+			if applied := mock.ApplyCallCount() == 1; applied != tt.wantApplied {
+				t.Fatalf("createOrApplyNamespace() applied: %t, want: %t", applied, tt.wantApplied)
+			}
+			if tt.wantApplied {
+				// only the desired labels and annotations are applied, and the others are retained by the server.
+				_, obj, _ := mock.ApplyArgsForCall(0)
+				assertNamespaceMetadata(t, obj.(*corev1.Namespace), testNamespace(tt.namespaceConfig))
+			}
+			assertEvent(t, r, tt.wantEvent)
+		})
+	}
+}
+
+// testNamespace returns the expected namespace object for the namespace config.
+func testNamespace(config *operatorv1alpha1.NamespaceConfig) *corev1.Namespace {
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "external-secrets",
+			Labels:      make(map[string]string),
+			Annotations: make(map[string]string),
+		},
+	}
+	for k, v := range controllerDefaultResourceLabels {
+		ns.Labels[k] = v
+	}
+	if config != nil {
+		for k, v := range config.Labels {
+			ns.Labels[k] = v
+		}
+		for k, v := range config.Annotations {
+			ns.Annotations[k] = v
+		}
+	}
+	return ns
+}
+
+func assertNamespaceMetadata(t *testing.T, got, want *corev1.Namespace) {
+	t.Helper()
+	if got.Name != want.Name {
+		t.Errorf("namespace name: %s, want: %s", got.Name, want.Name)
+	}
+	if !reflect.DeepEqual(got.Labels, want.Labels) {
+		t.Errorf("namespace labels: %v, want: %v", got.Labels, want.Labels)
+	}
+	annotations := maps.Clone(got.Annotations)
+	if annotations[common.DesiredStateHashAnnotation] == "" {
+		t.Errorf("namespace annotations: %v, want %s annotation", got.Annotations, common.DesiredStateHashAnnotation)
+	}
+	delete(annotations, common.DesiredStateHashAnnotation)
+	if !reflect.DeepEqual(annotations, want.Annotations) {
+		t.Errorf("namespace annotations: %v, want: %v", got.Annotations, want.Annotations)
+	}
+}
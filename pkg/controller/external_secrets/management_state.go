@@ -0,0 +1,113 @@
+package external_secrets
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+)
+
+// getManagementState returns the managementState configured in ExternalSecretsConfig CR Spec, which is
+// Managed when not configured.
+func getManagementState(esc *operatorv1alpha1.ExternalSecretsConfig) operatorv1alpha1.ManagementState {
+	if esc.Spec.ManagementState == "" {
+		return operatorv1alpha1.Managed
+	}
+	return esc.Spec.ManagementState
+}
+
+// isDriftCorrectionDisabled returns whether the resource is annotated for opting out of drift correction,
+// in which case the resource is not updated to the desired state. The resource is recorded for reporting
+// it in the ReconciliationPaused condition.
+func (r *Reconciler) isDriftCorrectionDisabled(fetched client.Object, kind string) bool {
+	if fetched.GetAnnotations()[driftCorrectionDisabledAnnotation] != "true" {
+		return false
+	}
+
+	r.driftCorrectionSkipped = append(r.driftCorrectionSkipped, resourceDisplayName(kind, fetched))
+	r.log.V(1).Info("drift correction is disabled for resource, not updating to desired state", "kind", kind, "name", client.ObjectKeyFromObject(fetched))
+	return true
+}
+
+// getReconciliationPausedCondition returns the condition indicating the operand resources are not being kept
+// in the desired state, and nil when all the resources are reconciled.
+func (r *Reconciler) getReconciliationPausedCondition(esc *operatorv1alpha1.ExternalSecretsConfig) *metav1.Condition {
+	cond := &metav1.Condition{
+		Type:               operatorv1alpha1.ReconciliationPaused,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: esc.GetGeneration(),
+	}
+	switch {
+	case getManagementState(esc) == operatorv1alpha1.Unmanaged:
+		cond.Reason = operatorv1alpha1.ReasonUnmanaged
+		cond.Message = "managementState is Unmanaged, operand resources are not reconciled"
+	case len(r.driftCorrectionSkipped) != 0:
+		skipped := slices.Clone(r.driftCorrectionSkipped)
+		slices.Sort(skipped)
+		cond.Reason = operatorv1alpha1.ReasonDriftCorrectionDisabled
+		cond.Message = fmt.Sprintf("resources annotated with %s are not reconciled to desired state: %s", driftCorrectionDisabledAnnotation, strings.Join(slices.Compact(skipped), ", "))
+	default:
+		return nil
+	}
+	return cond
+}
+
+// processRemovedRequest deletes the resources created for the `external-secrets` operand when the
+// managementState is Removed, and reports the operand as removed once all the resources are deleted.
+func (r *Reconciler) processRemovedRequest(esc *operatorv1alpha1.ExternalSecretsConfig, req types.NamespacedName) (ctrl.Result, error) {
+	inProgress, err := r.deleteExternalSecretsDeployment(esc)
+	if err != nil {
+		r.log.Error(err, "failed to remove external-secrets deployment", "request", req)
+		recordReconcileError(err)
+		return ctrl.Result{RequeueAfter: common.DefaultRequeueTime}, fmt.Errorf("failed to remove %q external-secrets deployment: %w", req, err)
+	}
+	if inProgress {
+		r.log.V(1).Info("external-secrets deployment removal in progress, requeuing", "request", req)
+		return ctrl.Result{RequeueAfter: common.DefaultRequeueTime}, nil
+	}
+
+	prevConditions := slices.Clone(esc.Status.Conditions)
+	for _, condType := range []string{
+		operatorv1alpha1.CoreControllerAvailable,
+		operatorv1alpha1.WebhookAvailable,
+		operatorv1alpha1.CertControllerAvailable,
+		operatorv1alpha1.BitwardenSDKServerAvailable,
+		operatorv1alpha1.CertificatesReady,
+		operatorv1alpha1.NetworkPoliciesApplied,
+		operatorv1alpha1.ReconciliationPaused,
+		operatorv1alpha1.UnsupportedConfigOverridesApplied,
+	} {
+		apimeta.RemoveStatusCondition(&esc.Status.Conditions, condType)
+	}
+	apimeta.SetStatusCondition(&esc.Status.Conditions, r.getCertManagerInstalledCondition(esc))
+	apimeta.SetStatusCondition(&esc.Status.Conditions, metav1.Condition{
+		Type:               operatorv1alpha1.Degraded,
+		Status:             metav1.ConditionFalse,
+		Reason:             operatorv1alpha1.ReasonReady,
+		ObservedGeneration: esc.GetGeneration(),
+	})
+	readyCondChanged := apimeta.SetStatusCondition(&esc.Status.Conditions, metav1.Condition{
+		Type:               operatorv1alpha1.Ready,
+		Status:             metav1.ConditionFalse,
+		Reason:             operatorv1alpha1.ReasonRemoved,
+		Message:            "managementState is Removed, all resources created for external-secrets deployment are deleted",
+		ObservedGeneration: esc.GetGeneration(),
+	})
+	if readyCondChanged {
+		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "RemoveDeployment", "managementState is Removed, all resources created for external-secrets deployment are deleted")
+	}
+	if !reflect.DeepEqual(prevConditions, esc.Status.Conditions) {
+		return ctrl.Result{}, r.updateCondition(esc, nil)
+	}
+	return ctrl.Result{}, nil
+}
@@ -0,0 +1,163 @@
+package external_secrets
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+)
+
+func TestDriftCorrectionDisabled(t *testing.T) {
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantUpdated bool
+		wantPaused  string
+	}{
+		{
+			name:        "modified resource reconciled back to desired state",
+			wantUpdated: true,
+		},
+		{
+			name:        "modified resource with drift correction disabled retained",
+			annotations: map[string]string{driftCorrectionDisabledAnnotation: "true"},
+			wantPaused:  "resources annotated with operator.openshift.io/external-secrets-unmanaged are not reconciled to desired state: poddisruptionbudget external-secrets/external-secrets-webhook-pdb",
+		},
+		{
+			name:        "annotation with value other than true is ignored",
+			annotations: map[string]string{driftCorrectionDisabledAnnotation: "yes"},
+			wantUpdated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+				pdb := testPodDisruptionBudget(webhookPodDisruptionBudgetAssetName)
+				pdb.Spec.MinAvailable = ptr.To(intstr.FromInt32(2))
+				pdb.SetAnnotations(tt.annotations)
+				pdb.DeepCopyInto(obj.(*policyv1.PodDisruptionBudget))
+				return true, nil
+			})
+
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ApplicationConfig.Replicas = &operatorv1alpha1.ComponentReplicas{Webhook: ptr.To(int32(2))}
+
+			if err := r.createOrApplyPodDisruptionBudgets(esc, controllerDefaultResourceLabels, false); err != nil {
+				t.Fatalf("createOrApplyPodDisruptionBudgets() unexpected error: %v", err)
+			}
+			if updated := mock.ApplyCallCount() != 0; updated != tt.wantUpdated {
+				t.Errorf("createOrApplyPodDisruptionBudgets() applied: %v, want: %v", updated, tt.wantUpdated)
+			}
+
+			cond := r.getReconciliationPausedCondition(esc)
+			switch {
+			case tt.wantPaused == "" && cond != nil:
+				t.Errorf("getReconciliationPausedCondition() unexpected condition: %v", cond)
+			case tt.wantPaused != "" && (cond == nil || cond.Reason != operatorv1alpha1.ReasonDriftCorrectionDisabled || cond.Message != tt.wantPaused):
+				t.Errorf("getReconciliationPausedCondition() condition: %v, want message: %s", cond, tt.wantPaused)
+			}
+		})
+	}
+}
+
+func TestGetReconciliationPausedCondition(t *testing.T) {
+	r := testReconciler(t)
+	r.driftCorrectionSkipped = []string{"deployment external-secrets/external-secrets"}
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ManagementState = operatorv1alpha1.Unmanaged
+
+	// managementState takes precedence over the resources opted out of drift correction.
+	cond := r.getReconciliationPausedCondition(esc)
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != operatorv1alpha1.ReasonUnmanaged {
+		t.Errorf("getReconciliationPausedCondition() condition: %v, want reason: %s", cond, operatorv1alpha1.ReasonUnmanaged)
+	}
+
+	esc.Spec.ManagementState = operatorv1alpha1.Managed
+	r.driftCorrectionSkipped = nil
+	if cond := r.getReconciliationPausedCondition(esc); cond != nil {
+		t.Errorf("getReconciliationPausedCondition() unexpected condition: %v", cond)
+	}
+}
+
+func TestProcessRemovedRequest(t *testing.T) {
+	tests := []struct {
+		name            string
+		preReq          func(*fakes.FakeCtrlClient)
+		wantRequeue     bool
+		wantReadyReason string
+		wantErr         string
+	}{
+		{
+			name: "resources being deleted",
+			preReq: func(m *fakes.FakeCtrlClient) {
+				m.ListCalls(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					if l, ok := list.(*appsv1.DeploymentList); ok {
+						l.Items = []appsv1.Deployment{*testDeployment(controllerDeploymentAssetName)}
+					}
+					return nil
+				})
+			},
+			wantRequeue:     true,
+			wantReadyReason: operatorv1alpha1.ReasonDeleting,
+		},
+		{
+			name:            "all resources deleted",
+			wantReadyReason: operatorv1alpha1.ReasonRemoved,
+		},
+		{
+			name: "listing resources fails",
+			preReq: func(m *fakes.FakeCtrlClient) {
+				m.ListReturns(commontest.TestClientError)
+			},
+			wantRequeue: true,
+			wantErr:     `failed to remove "/cluster" external-secrets deployment: failed to list validatingwebhookconfiguration resources for deletion: test client error`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			if tt.preReq != nil {
+				tt.preReq(mock)
+			}
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ManagementState = operatorv1alpha1.Removed
+			apimeta.SetStatusCondition(&esc.Status.Conditions, metav1.Condition{Type: operatorv1alpha1.WebhookAvailable, Status: metav1.ConditionTrue, Reason: operatorv1alpha1.ReasonAvailable})
+
+			result, err := r.processRemovedRequest(esc, client.ObjectKeyFromObject(esc))
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("processRemovedRequest() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if requeue := result.RequeueAfter != 0; requeue != tt.wantRequeue {
+				t.Errorf("processRemovedRequest() requeue: %v, want: %v", requeue, tt.wantRequeue)
+			}
+			if tt.wantReadyReason == "" {
+				return
+			}
+			if cond := apimeta.FindStatusCondition(esc.Status.Conditions, operatorv1alpha1.Ready); cond == nil || cond.Reason != tt.wantReadyReason {
+				t.Errorf("processRemovedRequest() ready condition: %v, want reason: %s", cond, tt.wantReadyReason)
+			}
+			// availability conditions are removed only once all the resources are deleted.
+			if removed := apimeta.FindStatusCondition(esc.Status.Conditions, operatorv1alpha1.WebhookAvailable) == nil; removed == tt.wantRequeue {
+				t.Errorf("processRemovedRequest() WebhookAvailable condition removed: %v, want: %v", removed, !tt.wantRequeue)
+			}
+		})
+	}
+}
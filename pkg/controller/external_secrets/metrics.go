@@ -0,0 +1,125 @@
+package external_secrets
+
+import (
+	"os"
+
+	"github.com/prometheus/client_golang/prometheus"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+)
+
+// metricsNamespace is the prefix of the metrics exposed by the operator.
+const metricsNamespace = "external_secrets_operator"
+
+var (
+	// driftCorrectionsTotal counts the updates made for restoring the resources created for the operand
+	// to the desired state, which are the ones modified by other actors or when the configuration changes.
+	driftCorrectionsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "drift_corrections_total",
+		Help:      "Number of updates made to restore the resources created for the operand to the desired state, by resource kind.",
+	}, []string{"kind"})
+
+	// reconcileErrorsTotal counts the failed reconciliations of the external-secrets deployment.
+	reconcileErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "reconcile_errors_total",
+		Help:      "Number of failed reconciliations of the external-secrets deployment, by the error type, irrecoverable or retryable, and the error cause.",
+	}, []string{"type", "cause"})
+
+	// featureEnabled indicates the optional features enabled in the ExternalSecretsConfig.
+	featureEnabled = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "feature_enabled",
+		Help:      "Whether the optional feature is enabled (1) or disabled (0) in the ExternalSecretsConfig.",
+	}, []string{"feature"})
+
+	// operandInfo exposes the versions of the operands deployed by the operator.
+	operandInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "operand_info",
+		Help:      "Version of the operand deployed by the operator, the value is always 1.",
+	}, []string{"operand", "version"})
+
+	// externalSecretsConfigCondition exposes the state of the ExternalSecretsConfig status conditions.
+	externalSecretsConfigCondition = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "externalsecretsconfig_condition",
+		Help:      "Whether the ExternalSecretsConfig status condition is true (1) or not (0).",
+	}, []string{"name", "condition"})
+)
+
+const (
+	reconcileErrorIrrecoverable = "irrecoverable"
+	reconcileErrorRetryable     = "retryable"
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		driftCorrectionsTotal,
+		reconcileErrorsTotal,
+		featureEnabled,
+		operandInfo,
+		externalSecretsConfigCondition,
+	)
+}
+
+// recordDriftCorrection records the update made for restoring a resource of the given kind to the desired state.
+func recordDriftCorrection(kind string) {
+	driftCorrectionsTotal.WithLabelValues(kind).Inc()
+}
+
+// recordReconcileError records the failed reconciliation, classified the same way as in the status conditions.
+func recordReconcileError(err error) {
+	errType := reconcileErrorRetryable
+	if common.IsIrrecoverableError(err) {
+		errType = reconcileErrorIrrecoverable
+	}
+	reconcileErrorsTotal.WithLabelValues(errType, string(common.GetErrorCause(err))).Inc()
+}
+
+// recordExternalSecretsConfigMetrics updates the gauges derived from the ExternalSecretsConfig spec and status.
+func recordExternalSecretsConfigMetrics(esc *operatorv1alpha1.ExternalSecretsConfig) {
+	features := map[string]bool{
+		"BitwardenSecretManagerProvider": isBitwardenConfigEnabled(esc),
+		"CertManager":                    isCertManagerConfigEnabled(esc),
+		"ClusterTrustedCABundle":         isClusterTrustedCABundleEnabled(esc),
+		"ServiceCA":                      common.IsServiceCAConfigEnabled(esc),
+		"Monitoring":                     isMonitoringEnabled(esc),
+		"PushSecret":                     isPushSecretEnabled(esc),
+		"ClusterPushSecret":              isClusterPushSecretEnabled(esc),
+		"ClusterExternalSecret":          isClusterExternalSecretEnabled(esc),
+		"ClusterSecretStore":             isClusterSecretStoreEnabled(esc),
+		"Generators":                     isGeneratorsEnabled(esc),
+	}
+	for feature, enabled := range features {
+		featureEnabled.WithLabelValues(feature).Set(boolToFloat64(enabled))
+	}
+
+	operandInfo.Reset()
+	operandInfo.WithLabelValues(externalsecretsCommonName, os.Getenv(externalsecretsImageVersionEnvVarName)).Set(1)
+	if isBitwardenConfigEnabled(esc) {
+		operandInfo.WithLabelValues("bitwarden-sdk-server", os.Getenv(bitwardenImageVersionEnvVarName)).Set(1)
+	}
+
+	for _, condType := range []string{operatorv1alpha1.Ready, operatorv1alpha1.Degraded} {
+		externalSecretsConfigCondition.WithLabelValues(esc.GetName(), condType).Set(boolToFloat64(apimeta.IsStatusConditionTrue(esc.Status.Conditions, condType)))
+	}
+}
+
+// resetExternalSecretsConfigMetrics removes the gauges derived from the ExternalSecretsConfig, once it is deleted.
+func resetExternalSecretsConfigMetrics() {
+	featureEnabled.Reset()
+	operandInfo.Reset()
+	externalSecretsConfigCondition.Reset()
+}
+
+func boolToFloat64(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,134 @@
+package external_secrets
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	policyv1 "k8s.io/api/policy/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+)
+
+func TestDriftCorrectionMetric(t *testing.T) {
+	r := testReconciler(t)
+	mock := &fakes.FakeCtrlClient{}
+	r.CtrlClient = mock
+	mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+		pdb := testPodDisruptionBudget(webhookPodDisruptionBudgetAssetName)
+		pdb.Spec.MinAvailable = ptr.To(intstr.FromInt32(2))
+		pdb.SetResourceVersion("1")
+		pdb.DeepCopyInto(obj.(*policyv1.PodDisruptionBudget))
+		return true, nil
+	})
+	// apply updating the resourceVersion indicates the resource was modified.
+	mock.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+		obj.SetResourceVersion("2")
+		return nil
+	})
+
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ApplicationConfig.Replicas = &operatorv1alpha1.ComponentReplicas{Webhook: ptr.To(int32(2))}
+
+	before := testutil.ToFloat64(driftCorrectionsTotal.WithLabelValues("poddisruptionbudget"))
+	if err := r.createOrApplyPodDisruptionBudgets(esc, controllerDefaultResourceLabels, false); err != nil {
+		t.Fatalf("createOrApplyPodDisruptionBudgets() unexpected error: %v", err)
+	}
+	if got := testutil.ToFloat64(driftCorrectionsTotal.WithLabelValues("poddisruptionbudget")) - before; got != 1 {
+		t.Errorf("drift corrections recorded: %v, want: 1", got)
+	}
+}
+
+func TestRecordReconcileError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		wantType  string
+		wantCause string
+	}{
+		{
+			name:      "irrecoverable error with cause",
+			err:       common.NewIrrecoverableError(fmt.Errorf("invalid"), "validation failed").WithCause(common.InvalidConfiguration),
+			wantType:  reconcileErrorIrrecoverable,
+			wantCause: string(common.InvalidConfiguration),
+		},
+		{
+			name:     "retry required error",
+			err:      common.NewRetryRequiredError(fmt.Errorf("not ready"), "waiting for resource"),
+			wantType: reconcileErrorRetryable,
+		},
+		{
+			name:     "wrapped irrecoverable error",
+			err:      fmt.Errorf("failed to reconcile: %w", common.NewIrrecoverableError(fmt.Errorf("forbidden"), "update failed")),
+			wantType: reconcileErrorIrrecoverable,
+		},
+		{
+			name:     "error not of ReconcileError type is retried",
+			err:      fmt.Errorf("test error"),
+			wantType: reconcileErrorRetryable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counter := reconcileErrorsTotal.WithLabelValues(tt.wantType, tt.wantCause)
+			before := testutil.ToFloat64(counter)
+			recordReconcileError(tt.err)
+			if got := testutil.ToFloat64(counter) - before; got != 1 {
+				t.Errorf("recordReconcileError() %s/%s errors recorded: %v, want: 1", tt.wantType, tt.wantCause, got)
+			}
+		})
+	}
+}
+
+func TestRecordExternalSecretsConfigMetrics(t *testing.T) {
+	t.Setenv(externalsecretsImageVersionEnvVarName, "v0.19.0")
+	t.Setenv(bitwardenImageVersionEnvVarName, "v1.0.0")
+	t.Cleanup(resetExternalSecretsConfigMetrics)
+
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.Plugins.BitwardenSecretManagerProvider = &operatorv1alpha1.BitwardenSecretManagerProvider{Mode: operatorv1alpha1.Enabled}
+	esc.Spec.ApplicationConfig.Features = &operatorv1alpha1.FeaturesConfig{PushSecret: operatorv1alpha1.Disabled}
+	apimeta.SetStatusCondition(&esc.Status.Conditions, metav1.Condition{Type: operatorv1alpha1.Ready, Status: metav1.ConditionTrue})
+	apimeta.SetStatusCondition(&esc.Status.Conditions, metav1.Condition{Type: operatorv1alpha1.Degraded, Status: metav1.ConditionFalse})
+
+	recordExternalSecretsConfigMetrics(esc)
+
+	wantGauges := []struct {
+		name  string
+		value float64
+		got   float64
+	}{
+		{name: "feature BitwardenSecretManagerProvider", value: 1, got: testutil.ToFloat64(featureEnabled.WithLabelValues("BitwardenSecretManagerProvider"))},
+		{name: "feature Monitoring", value: 0, got: testutil.ToFloat64(featureEnabled.WithLabelValues("Monitoring"))},
+		{name: "feature PushSecret", value: 0, got: testutil.ToFloat64(featureEnabled.WithLabelValues("PushSecret"))},
+		{name: "feature ClusterPushSecret", value: 0, got: testutil.ToFloat64(featureEnabled.WithLabelValues("ClusterPushSecret"))},
+		{name: "feature ClusterSecretStore", value: 1, got: testutil.ToFloat64(featureEnabled.WithLabelValues("ClusterSecretStore"))},
+		{name: "external-secrets operand version", value: 1, got: testutil.ToFloat64(operandInfo.WithLabelValues("external-secrets", "v0.19.0"))},
+		{name: "bitwarden operand version", value: 1, got: testutil.ToFloat64(operandInfo.WithLabelValues("bitwarden-sdk-server", "v1.0.0"))},
+		{name: "Ready condition", value: 1, got: testutil.ToFloat64(externalSecretsConfigCondition.WithLabelValues(esc.GetName(), operatorv1alpha1.Ready))},
+		{name: "Degraded condition", value: 0, got: testutil.ToFloat64(externalSecretsConfigCondition.WithLabelValues(esc.GetName(), operatorv1alpha1.Degraded))},
+	}
+	for _, g := range wantGauges {
+		if g.got != g.value {
+			t.Errorf("recordExternalSecretsConfigMetrics() %s: %v, want: %v", g.name, g.got, g.value)
+		}
+	}
+
+	// bitwarden version is not exposed once the plugin is disabled.
+	esc.Spec.Plugins.BitwardenSecretManagerProvider.Mode = operatorv1alpha1.Disabled
+	recordExternalSecretsConfigMetrics(esc)
+	if got := testutil.CollectAndCount(operandInfo); got != 1 {
+		t.Errorf("recordExternalSecretsConfigMetrics() operand versions: %d, want: 1", got)
+	}
+}
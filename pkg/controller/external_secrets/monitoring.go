@@ -0,0 +1,217 @@
+package external_secrets
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/operator/assets"
+)
+
+var (
+	// serviceMonitorGVK and prometheusRuleGVK are the group/version/kind of the prometheus-operator
+	// resources, which are handled as unstructured objects since the API is not vendored.
+	serviceMonitorGVK = schema.GroupVersionKind{
+		Group:   "monitoring.coreos.com",
+		Version: "v1",
+		Kind:    "ServiceMonitor",
+	}
+	prometheusRuleGVK = schema.GroupVersionKind{
+		Group:   "monitoring.coreos.com",
+		Version: "v1",
+		Kind:    "PrometheusRule",
+	}
+)
+
+// newServiceMonitorObject returns an empty unstructured object for the prometheus-operator ServiceMonitor.
+func newServiceMonitorObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(serviceMonitorGVK)
+	return obj
+}
+
+// newPrometheusRuleObject returns an empty unstructured object for the prometheus-operator PrometheusRule.
+func newPrometheusRuleObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(prometheusRuleGVK)
+	return obj
+}
+
+// newUnstructuredList returns an empty unstructured list for the objects of the given kind.
+func newUnstructuredList(gvk schema.GroupVersionKind) *unstructured.UnstructuredList {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+	return list
+}
+
+// isMonitoringEnabled returns whether monitoring of the operand is enabled in ExternalSecretsConfig CR Spec.
+func isMonitoringEnabled(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	return esc.Spec.ControllerConfig.Monitoring != nil && common.EvalMode(esc.Spec.ControllerConfig.Monitoring.Mode)
+}
+
+// isMonitoringInstalled returns whether the prometheus-operator ServiceMonitor and PrometheusRule CRDs are available.
+func (r *Reconciler) isMonitoringInstalled() bool {
+	_, ok := r.optionalResourcesList[monitoringCRDGKV]
+	return ok
+}
+
+// syncMonitoringInstallation is for detecting the prometheus-operator CRDs being installed or removed after
+// the controller is started. The watches on the ServiceMonitor and PrometheusRule resources are added when
+// both the CRDs are available, and the informers are removed when either of the CRDs is deleted.
+func (r *Reconciler) syncMonitoringInstallation(esc *operatorv1alpha1.ExternalSecretsConfig) error {
+	installed := true
+	for _, name := range []string{serviceMonitorCRDObjectName, prometheusRuleCRDObjectName} {
+		established, err := r.isCRDEstablished(name)
+		if err != nil {
+			return err
+		}
+		installed = installed && established
+	}
+
+	switch {
+	case installed && !r.isMonitoringInstalled():
+		if r.controller != nil {
+			for _, obj := range []client.Object{newServiceMonitorObject(), newPrometheusRuleObject()} {
+				src := source.Kind[client.Object](r.cache, obj, handler.EnqueueRequestsFromMapFunc(r.managedResourceMapFunc), managedResources)
+				if err := r.controller.Watch(src); err != nil {
+					return fmt.Errorf("failed to add watch on %s resources: %w", obj.GetObjectKind().GroupVersionKind().Kind, err)
+				}
+			}
+		}
+		r.optionalResourcesList[monitoringCRDGKV] = struct{}{}
+		r.log.Info("prometheus-operator CRDs detected", "crds", []string{serviceMonitorCRDObjectName, prometheusRuleCRDObjectName})
+		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "MonitoringInstalled", "%s and %s CRDs are available", serviceMonitorCRDObjectName, prometheusRuleCRDObjectName)
+	case !installed && r.isMonitoringInstalled():
+		if r.cache != nil {
+			for _, obj := range []client.Object{newServiceMonitorObject(), newPrometheusRuleObject()} {
+				if err := r.cache.RemoveInformer(r.ctx, obj); err != nil {
+					return fmt.Errorf("failed to remove %s informer: %w", obj.GetObjectKind().GroupVersionKind().Kind, err)
+				}
+			}
+		}
+		delete(r.optionalResourcesList, monitoringCRDGKV)
+		r.log.Info("prometheus-operator CRDs removal detected", "crds", []string{serviceMonitorCRDObjectName, prometheusRuleCRDObjectName})
+		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "MonitoringRemoved", "%s or %s CRD is not available", serviceMonitorCRDObjectName, prometheusRuleCRDObjectName)
+	}
+	return nil
+}
+
+// createOrApplyMonitoring handles the creation of the ServiceMonitors for the operand metrics services, and of
+// the PrometheusRule with the operand alerts, along with the NetworkPolicy and RBAC required for the cluster
+// monitoring Prometheus to scrape the metrics. The resources are created only when monitoring is enabled and
+// the prometheus-operator CRDs are available, and are removed by pruning otherwise.
+func (r *Reconciler) createOrApplyMonitoring(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string, externalSecretsConfigCreateRecon bool) error {
+	if !isMonitoringEnabled(esc) {
+		return nil
+	}
+	if !r.isMonitoringInstalled() {
+		r.log.V(1).Info("monitoring is enabled, but prometheus-operator CRDs are not available, skipping", "crds", []string{serviceMonitorCRDObjectName, prometheusRuleCRDObjectName})
+		return nil
+	}
+
+	monitoringResources := []struct {
+		assetName string
+		condition bool
+	}{
+		{
+			assetName: metricsServiceMonitorAssetName,
+			condition: true,
+		},
+		{
+			assetName: certControllerMetricsServiceMonitorAssetName,
+			condition: isCertControllerEnabled(esc),
+		},
+		{
+			assetName: prometheusRuleAssetName,
+			condition: true,
+		},
+	}
+
+	for _, res := range monitoringResources {
+		if !res.condition {
+			continue
+		}
+		if err := r.createOrApplyMonitoringResource(esc, getMonitoringObject(esc, res.assetName, resourceLabels), externalSecretsConfigCreateRecon); err != nil {
+			return err
+		}
+	}
+	return r.createOrApplyClusterMonitoringAccess(esc, resourceLabels, externalSecretsConfigCreateRecon)
+}
+
+// createOrApplyClusterMonitoringAccess handles the creation of the NetworkPolicy allowing the metrics ingress
+// from the openshift-monitoring namespace, and of the Role and RoleBinding for the prometheus-k8s ServiceAccount
+// to discover the scrape targets in the operand namespace.
+func (r *Reconciler) createOrApplyClusterMonitoringAccess(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string, externalSecretsConfigCreateRecon bool) error {
+	if err := r.createOrApplyNetworkPolicyFromAsset(esc, allowClusterMonitoringTrafficAssetName, resourceLabels, externalSecretsConfigCreateRecon); err != nil {
+		return err
+	}
+	if err := r.createOrApplyRole(esc, r.getRoleObject(esc, prometheusRoleAssetName, resourceLabels), externalSecretsConfigCreateRecon); err != nil {
+		return err
+	}
+	return r.createOrApplyRoleBinding(esc, getPrometheusRoleBindingObject(esc, resourceLabels), externalSecretsConfigCreateRecon)
+}
+
+// getPrometheusRoleBindingObject is for obtaining the content of the prometheus-k8s RoleBinding static asset,
+// and then updating it with desired values. The subject namespace is retained, since the ServiceAccount is
+// of the cluster monitoring stack.
+func getPrometheusRoleBindingObject(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string) *rbacv1.RoleBinding {
+	roleBinding := common.DecodeRoleBindingObjBytes(assets.MustAsset(prometheusRoleBindingAssetName))
+	updateNamespace(roleBinding, esc)
+	common.UpdateResourceLabels(roleBinding, resourceLabels)
+	return roleBinding
+}
+
+// getMonitoringObject decodes the monitoring resource from the asset, and updates the namespace in
+// which it is created, along with the namespace matchers in the PrometheusRule alert expressions.
+func getMonitoringObject(esc *operatorv1alpha1.ExternalSecretsConfig, assetName string, resourceLabels map[string]string) *unstructured.Unstructured {
+	obj := common.DecodeUnstructuredObjBytes(assets.MustAsset(assetName))
+	staticNamespace := obj.GetNamespace()
+	updateNamespace(obj, esc)
+	common.UpdateResourceLabels(obj, resourceLabels)
+
+	if obj.GroupVersionKind() == prometheusRuleGVK && obj.GetNamespace() != staticNamespace {
+		updatePrometheusRuleNamespace(obj, staticNamespace, obj.GetNamespace())
+	}
+	return obj
+}
+
+// updatePrometheusRuleNamespace replaces the namespace matcher of the static manifest in the alert
+// expressions, for the alerts to be evaluated on the metrics of the operand namespace.
+func updatePrometheusRuleNamespace(obj *unstructured.Unstructured, oldNamespace, newNamespace string) {
+	groups, _, _ := unstructured.NestedSlice(obj.Object, "spec", "groups")
+	for _, group := range groups {
+		g, ok := group.(map[string]any)
+		if !ok {
+			continue
+		}
+		rules, _, _ := unstructured.NestedSlice(g, "rules")
+		for _, rule := range rules {
+			ru, ok := rule.(map[string]any)
+			if !ok {
+				continue
+			}
+			if expr, ok := ru["expr"].(string); ok {
+				ru["expr"] = strings.ReplaceAll(expr, fmt.Sprintf("namespace=%q", oldNamespace), fmt.Sprintf("namespace=%q", newNamespace))
+			}
+		}
+		_ = unstructured.SetNestedSlice(g, rules, "rules")
+	}
+	_ = unstructured.SetNestedSlice(obj.Object, groups, "spec", "groups")
+}
+
+// createOrApplyMonitoringResource ensures the ServiceMonitor or PrometheusRule exists in the cluster, and is
+// in the desired state.
+func (r *Reconciler) createOrApplyMonitoringResource(esc *operatorv1alpha1.ExternalSecretsConfig, desired *unstructured.Unstructured, externalSecretsConfigCreateRecon bool) error {
+	fetched := &unstructured.Unstructured{}
+	fetched.SetGroupVersionKind(desired.GroupVersionKind())
+	return r.applyResource(esc, desired, fetched, strings.ToLower(desired.GetKind()), externalSecretsConfigCreateRecon)
+}
@@ -0,0 +1,255 @@
+package external_secrets
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	crdv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+)
+
+func TestSyncMonitoringInstallation(t *testing.T) {
+	tests := []struct {
+		name          string
+		crds          []string
+		installed     bool
+		existsErr     error
+		wantInstalled bool
+		wantErr       string
+	}{
+		{
+			name: "prometheus-operator not installed",
+		},
+		{
+			name:          "prometheus-operator installed after controller start",
+			crds:          []string{serviceMonitorCRDObjectName, prometheusRuleCRDObjectName},
+			wantInstalled: true,
+		},
+		{
+			name: "only ServiceMonitor CRD installed",
+			crds: []string{serviceMonitorCRDObjectName},
+		},
+		{
+			name:      "PrometheusRule CRD removed after controller start",
+			crds:      []string{serviceMonitorCRDObjectName},
+			installed: true,
+		},
+		{
+			name:          "prometheus-operator remains installed",
+			crds:          []string{serviceMonitorCRDObjectName, prometheusRuleCRDObjectName},
+			installed:     true,
+			wantInstalled: true,
+		},
+		{
+			name:      "fetching CRD fails",
+			existsErr: commontest.TestClientError,
+			installed: true,
+			wantErr:   "failed to fetch servicemonitors.monitoring.coreos.com customresourcedefinition: test client error",
+			// state is retained when the CRDs could not be checked.
+			wantInstalled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			mock.ExistsCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+				if tt.existsErr != nil {
+					return false, tt.existsErr
+				}
+				for _, name := range tt.crds {
+					if name == key.Name {
+						crd := testCertificateCRD(crdv1.ConditionTrue)
+						crd.SetName(name)
+						crd.DeepCopyInto(obj.(*crdv1.CustomResourceDefinition))
+						return true, nil
+					}
+				}
+				return false, nil
+			})
+			if tt.installed {
+				r.optionalResourcesList[monitoringCRDGKV] = struct{}{}
+			}
+
+			err := r.syncMonitoringInstallation(commontest.TestExternalSecretsConfig())
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("syncMonitoringInstallation() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if got := r.isMonitoringInstalled(); got != tt.wantInstalled {
+				t.Errorf("syncMonitoringInstallation() installed: %v, want: %v", got, tt.wantInstalled)
+			}
+		})
+	}
+}
+
+func TestCreateOrApplyMonitoring(t *testing.T) {
+	tests := []struct {
+		name                        string
+		installed                   bool
+		preReq                      func(*Reconciler, *fakes.FakeCtrlClient)
+		updateExternalSecretsConfig func(*operatorv1alpha1.ExternalSecretsConfig)
+		wantApplied                 []string
+		wantErr                     string
+	}{
+		{
+			name:      "monitoring not enabled",
+			installed: true,
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.Monitoring = nil
+			},
+		},
+		{
+			name: "prometheus-operator not installed",
+		},
+		{
+			name:        "monitoring resources created",
+			installed:   true,
+			wantApplied: []string{"ServiceMonitor/external-secrets-metrics", "ServiceMonitor/external-secrets-cert-controller-metrics", "PrometheusRule/external-secrets-rules", "NetworkPolicy/allow-metrics-ingress-from-cluster-monitoring", "Role/external-secrets-prometheus-k8s", "RoleBinding/external-secrets-prometheus-k8s"},
+		},
+		{
+			name:      "cert-controller ServiceMonitor skipped when cert-manager enabled",
+			installed: true,
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = &operatorv1alpha1.CertProvidersConfig{
+					CertManager: &operatorv1alpha1.CertManagerConfig{Mode: operatorv1alpha1.Enabled},
+				}
+			},
+			wantApplied: []string{"ServiceMonitor/external-secrets-metrics", "PrometheusRule/external-secrets-rules", "NetworkPolicy/allow-metrics-ingress-from-cluster-monitoring", "Role/external-secrets-prometheus-k8s", "RoleBinding/external-secrets-prometheus-k8s"},
+		},
+		{
+			name:      "modified ServiceMonitor reconciled back to desired state",
+			installed: true,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+					u, ok := obj.(*unstructured.Unstructured)
+					if !ok {
+						return false, nil
+					}
+					assetName := prometheusRuleAssetName
+					if u.GetKind() == "ServiceMonitor" {
+						assetName = metricsServiceMonitorAssetName
+						if key.Name != "external-secrets-metrics" {
+							assetName = certControllerMetricsServiceMonitorAssetName
+						}
+					}
+					desired := getMonitoringObject(commontest.TestExternalSecretsConfig(), assetName, controllerDefaultResourceLabels)
+					if key.Name == "external-secrets-metrics" {
+						_ = unstructured.SetNestedSlice(desired.Object, []any{map[string]any{"port": "http"}}, "spec", "endpoints")
+					}
+					desired.DeepCopyInto(u)
+					return true, nil
+				})
+			},
+			wantApplied: []string{"ServiceMonitor/external-secrets-metrics", "ServiceMonitor/external-secrets-cert-controller-metrics", "PrometheusRule/external-secrets-rules", "NetworkPolicy/allow-metrics-ingress-from-cluster-monitoring", "Role/external-secrets-prometheus-k8s", "RoleBinding/external-secrets-prometheus-k8s"},
+		},
+		{
+			name:      "PrometheusRule creation fails",
+			installed: true,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					if obj.GetObjectKind().GroupVersionKind() == prometheusRuleGVK {
+						return commontest.TestClientError
+					}
+					return nil
+				})
+			},
+			wantErr: "failed to apply external-secrets/external-secrets-rules prometheusrule resource: test client error",
+		},
+		{
+			name:      "prometheus-k8s RoleBinding creation fails",
+			installed: true,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					if _, ok := obj.(*rbacv1.RoleBinding); ok {
+						return commontest.TestClientError
+					}
+					return nil
+				})
+			},
+			wantErr: "failed to apply external-secrets/external-secrets-prometheus-k8s rolebinding resource: test client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			if tt.installed {
+				r.optionalResourcesList[monitoringCRDGKV] = struct{}{}
+			}
+			if tt.preReq != nil {
+				tt.preReq(r, mock)
+			}
+
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ControllerConfig.Monitoring = &operatorv1alpha1.MonitoringConfig{Mode: operatorv1alpha1.Enabled}
+			if tt.updateExternalSecretsConfig != nil {
+				tt.updateExternalSecretsConfig(esc)
+			}
+
+			err := r.createOrApplyMonitoring(esc, controllerDefaultResourceLabels, false)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("createOrApplyMonitoring() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if tt.wantErr != "" {
+				return
+			}
+
+			var applied []string
+			for i := range mock.ApplyCallCount() {
+				_, obj, _ := mock.ApplyArgsForCall(i)
+				applied = append(applied, fmt.Sprintf("%s/%s", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName()))
+			}
+			if strings.Join(applied, ",") != strings.Join(tt.wantApplied, ",") {
+				t.Errorf("createOrApplyMonitoring() applied: %v, want: %v", applied, tt.wantApplied)
+			}
+		})
+	}
+}
+
+func TestGetMonitoringObjectWithCustomNamespace(t *testing.T) {
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ApplicationConfig.Namespace = "eso-operand"
+
+	rule := getMonitoringObject(esc, prometheusRuleAssetName, controllerDefaultResourceLabels)
+	if rule.GetNamespace() != "eso-operand" {
+		t.Errorf("getMonitoringObject() namespace: %s, want: eso-operand", rule.GetNamespace())
+	}
+
+	groups, _, _ := unstructured.NestedSlice(rule.Object, "spec", "groups")
+	rules, _, _ := unstructured.NestedSlice(groups[0].(map[string]any), "rules")
+	if len(rules) == 0 {
+		t.Fatalf("getMonitoringObject() PrometheusRule has no rules")
+	}
+	for _, r := range rules {
+		expr := r.(map[string]any)["expr"].(string)
+		if !strings.Contains(expr, `namespace="eso-operand"`) || strings.Contains(expr, `namespace="external-secrets"`) {
+			t.Errorf("getMonitoringObject() expr not updated with operand namespace: %s", expr)
+		}
+	}
+}
+
+func TestGetPrometheusRoleBindingObjectWithCustomNamespace(t *testing.T) {
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ApplicationConfig.Namespace = "eso-operand"
+
+	roleBinding := getPrometheusRoleBindingObject(esc, controllerDefaultResourceLabels)
+	if roleBinding.GetNamespace() != "eso-operand" {
+		t.Errorf("getPrometheusRoleBindingObject() namespace: %s, want: eso-operand", roleBinding.GetNamespace())
+	}
+	want := rbacv1.Subject{Kind: roleBindingSubjectKind, Name: "prometheus-k8s", Namespace: "openshift-monitoring"}
+	if len(roleBinding.Subjects) != 1 || roleBinding.Subjects[0] != want {
+		t.Errorf("getPrometheusRoleBindingObject() subjects: %v, want: [%v]", roleBinding.Subjects, want)
+	}
+}
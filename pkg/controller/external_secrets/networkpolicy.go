@@ -3,10 +3,8 @@ package external_secrets
 import (
 	"fmt"
 
-	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/common"
@@ -29,6 +27,27 @@ func (r *Reconciler) createOrApplyNetworkPolicies(esc *operatorv1alpha1.External
 	return nil
 }
 
+// getNetworkPoliciesAppliedCondition returns the condition indicating whether the network policies
+// are applied, based on the error from applying them.
+func getNetworkPoliciesAppliedCondition(esc *operatorv1alpha1.ExternalSecretsConfig, err error) metav1.Condition {
+	cond := metav1.Condition{
+		Type:               operatorv1alpha1.NetworkPoliciesApplied,
+		Status:             metav1.ConditionTrue,
+		Reason:             operatorv1alpha1.ReasonApplied,
+		Message:            "network policies applied",
+		ObservedGeneration: esc.GetGeneration(),
+	}
+	if err != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = operatorv1alpha1.ReasonFailed
+		if cause := common.GetErrorCause(err); cause != "" {
+			cond.Reason = string(cause)
+		}
+		cond.Message = err.Error()
+	}
+	return cond
+}
+
 // createOrApplyStaticNetworkPolicies applies the static network policy manifests from bindata.
 func (r *Reconciler) createOrApplyStaticNetworkPolicies(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string, externalSecretsConfigCreateRecon bool) error {
 	// Define static network policy assets to apply
@@ -50,7 +69,7 @@ func (r *Reconciler) createOrApplyStaticNetworkPolicies(esc *operatorv1alpha1.Ex
 		},
 		{
 			assetName: allowCertControllerTrafficAssetName,
-			condition: !isCertManagerConfigEnabled(esc), // Only if cert-controller is enabled
+			condition: isCertControllerEnabled(esc), // Only if cert-controller is enabled
 		},
 		{
 			assetName: allowBitwardenServerTrafficAssetName,
@@ -98,37 +117,7 @@ func (r *Reconciler) createOrApplyCustomNetworkPolicy(esc *operatorv1alpha1.Exte
 	if err != nil {
 		return err
 	}
-
-	networkPolicyName := fmt.Sprintf("%s/%s", networkPolicy.GetNamespace(), networkPolicy.GetName())
-	r.log.V(4).Info("Reconciling custom network policy", "name", networkPolicyName, "component", npConfig.ComponentName)
-
-	fetched := &networkingv1.NetworkPolicy{}
-	exists, err := r.Exists(r.ctx, client.ObjectKeyFromObject(networkPolicy), fetched)
-	if err != nil {
-		return common.FromClientError(err, "failed to check existence of network policy %s", networkPolicyName)
-	}
-
-	if exists {
-		if externalSecretsConfigCreateRecon {
-			r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "NetworkPolicy %s already exists", networkPolicyName)
-		}
-		if common.HasObjectChanged(networkPolicy, fetched) {
-			r.log.V(1).Info("NetworkPolicy modified, updating", "name", networkPolicyName)
-			if err := r.UpdateWithRetry(r.ctx, networkPolicy); err != nil {
-				return common.FromClientError(err, "failed to update network policy %s", networkPolicyName)
-			}
-			r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "NetworkPolicy %s updated", networkPolicyName)
-		} else {
-			r.log.V(4).Info("NetworkPolicy already up-to-date", "name", networkPolicyName)
-		}
-	} else {
-		if err := r.Create(r.ctx, networkPolicy); err != nil {
-			return common.FromClientError(err, "failed to create network policy %s", networkPolicyName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "NetworkPolicy %s created", networkPolicyName)
-	}
-
-	return nil
+	return r.applyResource(esc, networkPolicy, &networkingv1.NetworkPolicy{}, "networkpolicy", externalSecretsConfigCreateRecon)
 }
 
 // createOrApplyNetworkPolicyFromAsset decodes a NetworkPolicy YAML asset and ensures it exists in the cluster.
@@ -136,37 +125,7 @@ func (r *Reconciler) createOrApplyNetworkPolicyFromAsset(esc *operatorv1alpha1.E
 	networkPolicy := common.DecodeNetworkPolicyObjBytes(assets.MustAsset(assetName))
 	updateNamespace(networkPolicy, esc)
 	common.UpdateResourceLabels(networkPolicy, resourceLabels)
-
-	networkPolicyName := fmt.Sprintf("%s/%s", networkPolicy.GetNamespace(), networkPolicy.GetName())
-	r.log.V(4).Info("Reconciling static network policy", "name", networkPolicyName)
-
-	fetched := &networkingv1.NetworkPolicy{}
-	exists, err := r.Exists(r.ctx, client.ObjectKeyFromObject(networkPolicy), fetched)
-	if err != nil {
-		return common.FromClientError(err, "failed to check existence of network policy %s", networkPolicyName)
-	}
-
-	if exists {
-		if externalSecretsConfigCreateRecon {
-			r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "NetworkPolicy %s already exists", networkPolicyName)
-		}
-		if common.HasObjectChanged(networkPolicy, fetched) {
-			r.log.V(1).Info("NetworkPolicy modified, updating", "name", networkPolicyName)
-			if err := r.UpdateWithRetry(r.ctx, networkPolicy); err != nil {
-				return common.FromClientError(err, "failed to update network policy %s", networkPolicyName)
-			}
-			r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "NetworkPolicy %s updated", networkPolicyName)
-		} else {
-			r.log.V(4).Info("NetworkPolicy already up-to-date", "name", networkPolicyName)
-		}
-	} else {
-		if err := r.Create(r.ctx, networkPolicy); err != nil {
-			return common.FromClientError(err, "failed to create network policy %s", networkPolicyName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "NetworkPolicy %s created", networkPolicyName)
-	}
-
-	return nil
+	return r.applyResource(esc, networkPolicy, &networkingv1.NetworkPolicy{}, "networkpolicy", externalSecretsConfigCreateRecon)
 }
 
 // buildNetworkPolicyFromConfig constructs a NetworkPolicy object from the API configuration.
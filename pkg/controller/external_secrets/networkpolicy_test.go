@@ -7,6 +7,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -14,6 +15,7 @@ import (
 
 	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
 	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
 )
 
@@ -52,7 +54,7 @@ func TestCreateOrApplyStaticNetworkPolicies(t *testing.T) {
 					expectedNPMap[name] = testNetworkPolicy(path)
 				}
 
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if np, ok := obj.(*networkingv1.NetworkPolicy); ok {
 						if _, found := expectedNPMap[np.Name]; found {
 							return nil
@@ -68,7 +70,7 @@ func TestCreateOrApplyStaticNetworkPolicies(t *testing.T) {
 				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					expectedNP := testNetworkPolicy(allowBitwardenServerTrafficAssetName)
 					if np, ok := obj.(*networkingv1.NetworkPolicy); ok {
 						if np.Name == expectedNP.Name {
@@ -94,7 +96,7 @@ func TestCreateOrApplyStaticNetworkPolicies(t *testing.T) {
 				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if np, ok := obj.(*networkingv1.NetworkPolicy); ok {
 						if np.Name == "allow-api-server-egress-for-cert-controller" {
 							return fmt.Errorf("cert-controller policy should not be created")
@@ -127,7 +129,7 @@ func TestCreateOrApplyStaticNetworkPolicies(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					return nil
 				})
 			},
@@ -138,14 +140,14 @@ func TestCreateOrApplyStaticNetworkPolicies(t *testing.T) {
 				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if np, ok := obj.(*networkingv1.NetworkPolicy); ok && np.Name == "deny-all-traffic" {
 						return commontest.TestClientError
 					}
 					return nil
 				})
 			},
-			wantErr: "failed to create network policy external-secrets/deny-all-traffic: test client error",
+			wantErr: "failed to apply external-secrets/deny-all-traffic networkpolicy resource: test client error",
 		},
 		{
 			name: "network policy exists check fails",
@@ -158,7 +160,7 @@ func TestCreateOrApplyStaticNetworkPolicies(t *testing.T) {
 					return true, nil
 				})
 			},
-			wantErr: "failed to check existence of network policy external-secrets/deny-all-traffic: test client error",
+			wantErr: "failed to check external-secrets/deny-all-traffic networkpolicy resource already exists: test client error",
 		},
 		{
 			name: "network policy update fails",
@@ -172,14 +174,14 @@ func TestCreateOrApplyStaticNetworkPolicies(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if _, ok := obj.(*networkingv1.NetworkPolicy); ok {
 						return commontest.TestClientError
 					}
 					return nil
 				})
 			},
-			wantErr: "failed to update network policy external-secrets/deny-all-traffic: test client error",
+			wantErr: "failed to apply external-secrets/deny-all-traffic networkpolicy resource: test client error",
 		},
 	}
 
@@ -228,7 +230,7 @@ func TestCreateOrApplyCustomNetworkPolicies(t *testing.T) {
 				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if np, ok := obj.(*networkingv1.NetworkPolicy); ok {
 						if np.Name != "test-custom-policy" {
 							return fmt.Errorf("unexpected network policy name: %s", np.Name)
@@ -280,7 +282,7 @@ func TestCreateOrApplyCustomNetworkPolicies(t *testing.T) {
 				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if _, ok := obj.(*networkingv1.NetworkPolicy); ok {
 						return commontest.TestClientError
 					}
@@ -296,7 +298,7 @@ func TestCreateOrApplyCustomNetworkPolicies(t *testing.T) {
 					},
 				}
 			},
-			wantErr: "failed to create network policy external-secrets/test-fail-policy: test client error",
+			wantErr: "failed to apply external-secrets/test-fail-policy networkpolicy resource: test client error",
 		},
 		{
 			name: "custom network policy updated successfully",
@@ -307,14 +309,14 @@ func TestCreateOrApplyCustomNetworkPolicies(t *testing.T) {
 						np := &networkingv1.NetworkPolicy{
 							ObjectMeta: metav1.ObjectMeta{
 								Name:      "test-update-policy",
-								Namespace: externalsecretsDefaultNamespace,
+								Namespace: common.ExternalSecretsDefaultNamespace,
 							},
 						}
 						np.DeepCopyInto(o)
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					return nil
 				})
 			},
@@ -499,3 +501,42 @@ func TestBuildNetworkPolicyFromConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNetworkPoliciesAppliedCondition(t *testing.T) {
+	forbiddenErr := apierrors.NewForbidden(networkingv1.Resource("networkpolicies"), "deny-all-traffic", fmt.Errorf("test forbidden"))
+
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "network policies applied",
+			wantStatus: metav1.ConditionTrue,
+			wantReason: operatorv1alpha1.ReasonApplied,
+		},
+		{
+			name:       "applying network policies fails with unknown cause",
+			err:        common.FromClientError(commontest.TestClientError, "failed to create network policy"),
+			wantStatus: metav1.ConditionFalse,
+			wantReason: operatorv1alpha1.ReasonFailed,
+		},
+		{
+			name:       "applying network policies is forbidden",
+			err:        common.FromClientError(forbiddenErr, "failed to create network policy"),
+			wantStatus: metav1.ConditionFalse,
+			wantReason: operatorv1alpha1.ReasonPermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond := getNetworkPoliciesAppliedCondition(commontest.TestExternalSecretsConfig(), tt.err)
+			if cond.Type != operatorv1alpha1.NetworkPoliciesApplied || cond.Status != tt.wantStatus || cond.Reason != tt.wantReason {
+				t.Errorf("getNetworkPoliciesAppliedCondition() got: %s/%s/%s, want: %s/%s/%s",
+					cond.Type, cond.Status, cond.Reason, operatorv1alpha1.NetworkPoliciesApplied, tt.wantStatus, tt.wantReason)
+			}
+		})
+	}
+}
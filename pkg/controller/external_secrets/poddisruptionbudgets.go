@@ -0,0 +1,45 @@
+package external_secrets
+
+import (
+	policyv1 "k8s.io/api/policy/v1"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/operator/assets"
+)
+
+// createOrApplyPodDisruptionBudgets is for creating the PodDisruptionBudgets of the components configured
+// with more than one replica, for at least one pod to remain available during voluntary disruptions, like
+// node drains. A budget is not created for a single replica, as it would block the node drains.
+func (r *Reconciler) createOrApplyPodDisruptionBudgets(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string, externalSecretsConfigCreateRecon bool) error {
+	pdbsToCreate := []struct {
+		assetName           string
+		deploymentAssetName string
+	}{
+		{
+			assetName:           controllerPodDisruptionBudgetAssetName,
+			deploymentAssetName: controllerDeploymentAssetName,
+		},
+		{
+			assetName:           webhookPodDisruptionBudgetAssetName,
+			deploymentAssetName: webhookDeploymentAssetName,
+		},
+	}
+
+	for _, pdb := range pdbsToCreate {
+		if getComponentReplicas(esc, pdb.deploymentAssetName) <= 1 {
+			continue
+		}
+		if err := r.createOrApplyPodDisruptionBudget(esc, pdb.assetName, resourceLabels, externalSecretsConfigCreateRecon); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Reconciler) createOrApplyPodDisruptionBudget(esc *operatorv1alpha1.ExternalSecretsConfig, assetName string, resourceLabels map[string]string, externalSecretsConfigCreateRecon bool) error {
+	desired := common.DecodePodDisruptionBudgetObjBytes(assets.MustAsset(assetName))
+	updateNamespace(desired, esc)
+	common.UpdateResourceLabels(desired, resourceLabels)
+	return r.applyResource(esc, desired, &policyv1.PodDisruptionBudget{}, "poddisruptionbudget", externalSecretsConfigCreateRecon)
+}
@@ -0,0 +1,117 @@
+package external_secrets
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+	"github.com/openshift/external-secrets-operator/pkg/operator/assets"
+)
+
+func testPodDisruptionBudget(assetName string) *policyv1.PodDisruptionBudget {
+	pdb := common.DecodePodDisruptionBudgetObjBytes(assets.MustAsset(assetName))
+	common.UpdateResourceLabels(pdb, controllerDefaultResourceLabels)
+	return pdb
+}
+
+func TestCreateOrApplyPodDisruptionBudgets(t *testing.T) {
+	tests := []struct {
+		name        string
+		preReq      func(*Reconciler, *fakes.FakeCtrlClient)
+		replicas    *operatorv1alpha1.ComponentReplicas
+		wantApplied []string
+		wantErr     string
+	}{
+		{
+			name: "single replica components do not have budgets",
+		},
+		{
+			name:        "budgets created for the components with multiple replicas",
+			replicas:    &operatorv1alpha1.ComponentReplicas{Controller: ptr.To(int32(2)), Webhook: ptr.To(int32(3))},
+			wantApplied: []string{"external-secrets-pdb", "external-secrets-webhook-pdb"},
+		},
+		{
+			name: "modified budget reconciled back to desired state",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+					pdb := testPodDisruptionBudget(webhookPodDisruptionBudgetAssetName)
+					pdb.Spec.MinAvailable = ptr.To(intstr.FromInt32(2))
+					pdb.DeepCopyInto(obj.(*policyv1.PodDisruptionBudget))
+					return true, nil
+				})
+			},
+			replicas:    &operatorv1alpha1.ComponentReplicas{Webhook: ptr.To(int32(2))},
+			wantApplied: []string{"external-secrets-webhook-pdb"},
+		},
+		{
+			name: "budget in desired state is applied",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+					testPodDisruptionBudget(controllerPodDisruptionBudgetAssetName).DeepCopyInto(obj.(*policyv1.PodDisruptionBudget))
+					return true, nil
+				})
+			},
+			replicas:    &operatorv1alpha1.ComponentReplicas{Controller: ptr.To(int32(2))},
+			wantApplied: []string{"external-secrets-pdb"},
+		},
+		{
+			name: "budget creation fails",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ApplyReturns(commontest.TestClientError)
+			},
+			replicas: &operatorv1alpha1.ComponentReplicas{Controller: ptr.To(int32(2))},
+			wantErr:  `failed to apply external-secrets/external-secrets-pdb poddisruptionbudget resource: test client error`,
+		},
+		{
+			name: "budget existence check fails",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ExistsReturns(false, commontest.TestClientError)
+			},
+			replicas: &operatorv1alpha1.ComponentReplicas{Webhook: ptr.To(int32(2))},
+			wantErr:  `failed to check external-secrets/external-secrets-webhook-pdb poddisruptionbudget resource already exists: test client error`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			if tt.preReq != nil {
+				tt.preReq(r, mock)
+			}
+			r.CtrlClient = mock
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.ApplicationConfig.Replicas = tt.replicas
+
+			err := r.createOrApplyPodDisruptionBudgets(esc, controllerDefaultResourceLabels, false)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("createOrApplyPodDisruptionBudgets() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			applied := make([]string, 0)
+			for i := 0; i < mock.ApplyCallCount(); i++ {
+				_, obj, _ := mock.ApplyArgsForCall(i)
+				applied = append(applied, obj.GetName())
+			}
+			if len(tt.wantApplied) == 0 {
+				tt.wantApplied = []string{}
+			}
+			if !slices.Equal(applied, tt.wantApplied) {
+				t.Errorf("createOrApplyPodDisruptionBudgets() applied: %v, want: %v", applied, tt.wantApplied)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package external_secrets
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+)
+
+var (
+	// clusterProxyGVK is the group/version/kind of the OpenShift cluster-wide proxy
+	// configuration object.
+	clusterProxyGVK = schema.GroupVersionKind{
+		Group:   "config.openshift.io",
+		Version: "v1",
+		Kind:    "Proxy",
+	}
+
+	// proxyEnvVarNames is the list of environment variable names, which will be
+	// set in the operand containers for the proxy configuration.
+	proxyEnvVarNames = []string{
+		httpProxyEnvVarName,
+		httpsProxyEnvVarName,
+		noProxyEnvVarName,
+		strings.ToLower(httpProxyEnvVarName),
+		strings.ToLower(httpsProxyEnvVarName),
+		strings.ToLower(noProxyEnvVarName),
+	}
+)
+
+const (
+	httpProxyEnvVarName  = "HTTP_PROXY"
+	httpsProxyEnvVarName = "HTTPS_PROXY"
+	noProxyEnvVarName    = "NO_PROXY"
+)
+
+// newClusterProxyObject returns an empty unstructured object for the OpenShift
+// cluster-wide proxy configuration.
+func newClusterProxyObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(clusterProxyGVK)
+	return obj
+}
+
+// isClusterProxyInstalled returns whether the OpenShift cluster-wide proxy CRD is available.
+func (r *Reconciler) isClusterProxyInstalled() bool {
+	_, ok := r.optionalResourcesList[clusterProxyCRDGKV]
+	return ok
+}
+
+// getProxyConfig returns the proxy configuration to be applied on the operand containers along with
+// the field path of the source it was read from. The configuration in ExternalSecretsConfig takes
+// precedence, followed by the configuration in ExternalSecretsManager and lastly the OpenShift
+// cluster-wide proxy configuration.
+func (r *Reconciler) getProxyConfig(esc *operatorv1alpha1.ExternalSecretsConfig) (*operatorv1alpha1.ProxyConfig, *field.Path, error) {
+	if esc.Spec.ApplicationConfig.Proxy != nil {
+		return esc.Spec.ApplicationConfig.Proxy, field.NewPath("spec", "appConfig", "proxy"), nil
+	}
+	if r.esm.Spec.GlobalConfig != nil && r.esm.Spec.GlobalConfig.Proxy != nil {
+		return r.esm.Spec.GlobalConfig.Proxy, field.NewPath("spec", "globalConfig", "proxy"), nil
+	}
+	if !r.isClusterProxyInstalled() {
+		return nil, nil, nil
+	}
+
+	proxy, err := r.getClusterProxyConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+	return proxy, field.NewPath(fmt.Sprintf("proxies.%s", clusterProxyGVK.Group), clusterProxyObjectName, "status"), nil
+}
+
+// getClusterProxyConfig reads the effective proxy configuration from the status of
+// `proxies.config.openshift.io/cluster` object, which is watched and read from the cache.
+func (r *Reconciler) getClusterProxyConfig() (*operatorv1alpha1.ProxyConfig, error) {
+	obj := newClusterProxyObject()
+	key := types.NamespacedName{Name: clusterProxyObjectName}
+	exist, err := r.Exists(r.ctx, key, obj)
+	if err != nil {
+		return nil, common.FromClientError(err, "failed to fetch %s.%s/%s object", clusterProxyCRDName, clusterProxyGVK.Group, clusterProxyObjectName)
+	}
+	if !exist {
+		return nil, nil
+	}
+
+	proxy := &operatorv1alpha1.ProxyConfig{}
+	proxy.HTTPProxy, _, _ = unstructured.NestedString(obj.Object, "status", "httpProxy")
+	proxy.HTTPSProxy, _, _ = unstructured.NestedString(obj.Object, "status", "httpsProxy")
+	proxy.NoProxy, _, _ = unstructured.NestedString(obj.Object, "status", "noProxy")
+
+	return proxy, nil
+}
+
+// updateProxyConfig sets the validated proxy configuration as environment variables in all containers
+// of the deployment.
+func (r *Reconciler) updateProxyConfig(deployment *appsv1.Deployment, esc *operatorv1alpha1.ExternalSecretsConfig) error {
+	proxy, fldPath, err := r.getProxyConfig(esc)
+	if err != nil {
+		return err
+	}
+
+	if proxy != nil {
+		if err := validateProxyConfig(proxy, fldPath).ToAggregate(); err != nil {
+			return common.NewIrrecoverableError(err, "invalid proxy configuration")
+		}
+	}
+
+	for i := range deployment.Spec.Template.Spec.Containers {
+		updateProxyEnvVars(&deployment.Spec.Template.Spec.Containers[i], proxy)
+	}
+	for i := range deployment.Spec.Template.Spec.InitContainers {
+		updateProxyEnvVars(&deployment.Spec.Template.Spec.InitContainers[i], proxy)
+	}
+
+	return nil
+}
+
+// updateProxyEnvVars replaces the proxy environment variables in the container with
+// the ones derived from the passed proxy configuration.
+func updateProxyEnvVars(container *corev1.Container, proxy *operatorv1alpha1.ProxyConfig) {
+	env := make([]corev1.EnvVar, 0, len(container.Env)+len(proxyEnvVarNames))
+	for _, e := range container.Env {
+		if isProxyEnvVar(e.Name) {
+			continue
+		}
+		env = append(env, e)
+	}
+
+	if proxy != nil {
+		for _, e := range []struct {
+			name, value string
+		}{
+			{name: httpProxyEnvVarName, value: proxy.HTTPProxy},
+			{name: httpsProxyEnvVarName, value: proxy.HTTPSProxy},
+			{name: noProxyEnvVarName, value: proxy.NoProxy},
+		} {
+			if e.value == "" {
+				continue
+			}
+			env = append(env,
+				corev1.EnvVar{Name: e.name, Value: e.value},
+				corev1.EnvVar{Name: strings.ToLower(e.name), Value: e.value},
+			)
+		}
+	}
+
+	if len(env) == 0 {
+		env = nil
+	}
+	container.Env = env
+}
+
+func isProxyEnvVar(name string) bool {
+	for _, n := range proxyEnvVarNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validateProxyConfig validates the proxy URLs and the noProxy entries.
+func validateProxyConfig(proxy *operatorv1alpha1.ProxyConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	errs = append(errs, validateProxyURL(proxy.HTTPProxy, fldPath.Child("httpProxy"))...)
+	errs = append(errs, validateProxyURL(proxy.HTTPSProxy, fldPath.Child("httpsProxy"))...)
+
+	if proxy.NoProxy == "" {
+		return errs
+	}
+	for _, entry := range strings.Split(proxy.NoProxy, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || entry == "*" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			if _, _, err := net.ParseCIDR(entry); err != nil {
+				errs = append(errs, field.Invalid(fldPath.Child("noProxy"), entry, "must be a valid CIDR"))
+			}
+			continue
+		}
+		if net.ParseIP(entry) != nil {
+			continue
+		}
+		// domain suffixes are allowed to have a leading `.` or `*.`, and hosts can have a port.
+		host := strings.TrimPrefix(strings.TrimPrefix(entry, "*"), ".")
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		if msgs := validation.IsDNS1123Subdomain(strings.ToLower(host)); len(msgs) != 0 {
+			errs = append(errs, field.Invalid(fldPath.Child("noProxy"), entry, "must be a valid IP, CIDR or domain name"))
+		}
+	}
+
+	return errs
+}
+
+// validateProxyURL validates that the passed value is an absolute http or https URL.
+func validateProxyURL(val string, fldPath *field.Path) field.ErrorList {
+	if val == "" {
+		return nil
+	}
+	// proxy URLs could have credentials embedded, which must not be
+	// made available in the error message and in turn in the status.
+	u, err := url.Parse(val)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, "<redacted>", "must be a valid URL")}
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return field.ErrorList{field.Invalid(fldPath, u.Redacted(), "URL scheme must be either http or https")}
+	}
+	if u.Hostname() == "" {
+		return field.ErrorList{field.Invalid(fldPath, u.Redacted(), "URL must have a host")}
+	}
+	return nil
+}
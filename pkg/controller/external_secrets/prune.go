@@ -0,0 +1,64 @@
+package external_secrets
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+)
+
+// resourceInventory is the set of resources desired for the `external-secrets` operand deployment
+// for the current configuration, which is built during every reconciliation. Each resource is
+// mapped to the hash of its desired state, which is empty for the resources not applied.
+type resourceInventory map[string]string
+
+func inventoryKey(obj client.Object) string {
+	// unstructured objects of all kinds are of the same type, and are told apart by the kind.
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		return fmt.Sprintf("%s/%s/%s", u.GroupVersionKind(), obj.GetNamespace(), obj.GetName())
+	}
+	return fmt.Sprintf("%T/%s/%s", obj, obj.GetNamespace(), obj.GetName())
+}
+
+func (i resourceInventory) add(obj client.Object) {
+	if i != nil {
+		i[inventoryKey(obj)] = obj.GetAnnotations()[common.DesiredStateHashAnnotation]
+	}
+}
+
+// hash returns the hash of the desired state of all the resources in the inventory.
+func (i resourceInventory) hash() string {
+	hash := sha256.New()
+	for _, key := range slices.Sorted(maps.Keys(i)) {
+		_, _ = fmt.Fprintf(hash, "%s=%s\n", key, i[key])
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+func (i resourceInventory) has(obj client.Object) bool {
+	_, ok := i[inventoryKey(obj)]
+	return ok
+}
+
+// pruneExternalSecretsResources deletes the resources created for the `external-secrets` operand which
+// are no longer desired, like the bitwarden resources when the plugin is disabled, or the cert-controller
+// resources when cert-manager is configured for the webhook certificates. Must be called only after all
+// the desired resources were reconciled successfully, since the inventory would be partial otherwise.
+func (r *Reconciler) pruneExternalSecretsResources(esc *operatorv1alpha1.ExternalSecretsConfig) error {
+	if len(r.inventory) == 0 {
+		return nil
+	}
+	for _, k := range r.getManagedResourceKinds() {
+		if _, err := r.deleteManagedResources(esc, k, r.inventory); err != nil {
+			return err
+		}
+	}
+	return nil
+}
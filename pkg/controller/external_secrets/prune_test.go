@@ -0,0 +1,150 @@
+package external_secrets
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+)
+
+func TestPruneExternalSecretsResources(t *testing.T) {
+	tests := []struct {
+		name        string
+		desired     []client.Object
+		preReq      func(*Reconciler, *fakes.FakeCtrlClient)
+		wantDeleted []string
+		wantListed  int
+		wantErr     string
+	}{
+		{
+			name:       "inventory is empty, nothing is pruned",
+			wantListed: 0,
+		},
+		{
+			name: "all resources are desired, nothing is pruned",
+			desired: []client.Object{
+				testDeployment("external-secrets"),
+				testService(webhookServiceAssetName),
+			},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ListCalls(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					switch l := list.(type) {
+					case *appsv1.DeploymentList:
+						l.Items = []appsv1.Deployment{*testDeployment("external-secrets")}
+					case *corev1.ServiceList:
+						l.Items = []corev1.Service{*testService(webhookServiceAssetName)}
+					}
+					return nil
+				})
+			},
+			wantListed: 12,
+		},
+		{
+			name: "bitwarden resources are pruned when plugin is disabled",
+			desired: []client.Object{
+				testDeployment("external-secrets"),
+				testService(webhookServiceAssetName),
+			},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ListCalls(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					switch l := list.(type) {
+					case *appsv1.DeploymentList:
+						l.Items = []appsv1.Deployment{
+							*testDeployment("external-secrets"),
+							*testDeployment("bitwarden-sdk-server"),
+						}
+					case *corev1.ServiceList:
+						l.Items = []corev1.Service{
+							*testService(webhookServiceAssetName),
+							*testService(bitwardenServiceAssetName),
+						}
+					}
+					return nil
+				})
+			},
+			wantDeleted: []string{"bitwarden-sdk-server", "bitwarden-sdk-server"},
+			wantListed:  12,
+		},
+		{
+			name:    "resource already being deleted is skipped",
+			desired: []client.Object{testDeployment("external-secrets")},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ListCalls(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					if l, ok := list.(*appsv1.DeploymentList); ok {
+						deleting := testDeployment("external-secrets-cert-controller")
+						deleting.SetDeletionTimestamp(ptr.To(metav1.Now()))
+						l.Items = []appsv1.Deployment{*testDeployment("external-secrets"), *deleting}
+					}
+					return nil
+				})
+			},
+			wantListed: 12,
+		},
+		{
+			name:    "deleting resource fails",
+			desired: []client.Object{testDeployment("external-secrets")},
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ListCalls(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					if l, ok := list.(*appsv1.DeploymentList); ok {
+						l.Items = []appsv1.Deployment{*testDeployment("external-secrets-cert-controller")}
+					}
+					return nil
+				})
+				m.DeleteReturns(commontest.TestClientError)
+			},
+			wantDeleted: []string{"external-secrets-cert-controller"},
+			wantListed:  2,
+			wantErr:     "failed to delete external-secrets-cert-controller deployment resource: test client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			if tt.preReq != nil {
+				tt.preReq(r, mock)
+			}
+			r.inventory = make(resourceInventory)
+			for _, obj := range tt.desired {
+				r.inventory.add(obj)
+			}
+
+			err := r.pruneExternalSecretsResources(commontest.TestExternalSecretsConfig())
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("pruneExternalSecretsResources() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if got := mock.ListCallCount(); got != tt.wantListed {
+				t.Errorf("pruneExternalSecretsResources() listed resource kinds: %d, want: %d", got, tt.wantListed)
+			}
+			if got := mock.DeleteCallCount(); got != len(tt.wantDeleted) {
+				t.Fatalf("pruneExternalSecretsResources() deleted resources: %d, want: %d", got, len(tt.wantDeleted))
+			}
+			for i, name := range tt.wantDeleted {
+				if _, obj, _ := mock.DeleteArgsForCall(i); obj.GetName() != name {
+					t.Errorf("pruneExternalSecretsResources() deleted resource: %s, want: %s", obj.GetName(), name)
+				}
+			}
+			for i := 0; i < mock.ListCallCount(); i++ {
+				_, _, opts := mock.ListArgsForCall(i)
+				listOpts := &client.ListOptions{}
+				for _, o := range opts {
+					o.ApplyToList(listOpts)
+				}
+				want := labels.SelectorFromSet(labels.Set(managedResourceSelector))
+				if listOpts.LabelSelector == nil || listOpts.LabelSelector.String() != want.String() {
+					t.Errorf("pruneExternalSecretsResources() list selector: %v, want: %s", listOpts.LabelSelector, want)
+				}
+			}
+		})
+	}
+}
@@ -1,11 +1,7 @@
 package external_secrets
 
 import (
-	"fmt"
-
-	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/common"
@@ -46,6 +42,7 @@ func (r *Reconciler) createOrApplyControllerRBACResources(esc *operatorv1alpha1.
 		controllerClusterRoleViewAssetName,
 	} {
 		clusterRoleObj := r.getClusterRoleObject(asset, resourceLabels)
+		removeDisabledFeatureRules(clusterRoleObj, esc)
 		if err := r.createOrApplyClusterRole(esc, clusterRoleObj, recon); err != nil {
 			r.log.Error(err, "failed to reconcile controller clusterrole resources")
 			return err
@@ -77,8 +74,8 @@ func (r *Reconciler) createOrApplyControllerRBACResources(esc *operatorv1alpha1.
 // createOrApplyCertControllerRBACResources is for creating all RBAC resources required by
 // the main external-secrets operand cert-controller.
 func (r *Reconciler) createOrApplyCertControllerRBACResources(esc *operatorv1alpha1.ExternalSecretsConfig, serviceAccountName string, resourceLabels map[string]string, recon bool) error {
-	if isCertManagerConfigEnabled(esc) {
-		r.log.V(4).Info("skipping cert-controller rbac resources reconciliation, as cert-manager config is enabled")
+	if !isCertControllerEnabled(esc) {
+		r.log.V(4).Info("skipping cert-controller rbac resources reconciliation, as cert-manager or service-ca config is enabled")
 		return nil
 	}
 
@@ -99,38 +96,7 @@ func (r *Reconciler) createOrApplyCertControllerRBACResources(esc *operatorv1alp
 
 // createOrApplyClusterRole creates or updates given ClusterRole object.
 func (r *Reconciler) createOrApplyClusterRole(esc *operatorv1alpha1.ExternalSecretsConfig, obj *rbacv1.ClusterRole, recon bool) error {
-	var (
-		exist           bool
-		err             error
-		clusterRoleName = obj.GetName()
-		fetched         = &rbacv1.ClusterRole{}
-	)
-
-	exist, err = r.Exists(r.ctx, client.ObjectKeyFromObject(obj), fetched)
-	if err != nil {
-		return common.FromClientError(err, "failed to check %s clusterrole resource already exists", clusterRoleName)
-	}
-
-	if exist && recon {
-		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "%s clusterrole resource already exists, maybe from previous installation", clusterRoleName)
-	}
-	if exist && common.HasObjectChanged(obj, fetched) {
-		r.log.V(1).Info("clusterrole has been modified, updating to desired state", "name", clusterRoleName)
-		if err := r.UpdateWithRetry(r.ctx, obj); err != nil {
-			return common.FromClientError(err, "failed to update %s clusterrole resource", clusterRoleName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "clusterrole resource %s reconciled back to desired state", clusterRoleName)
-	} else {
-		r.log.V(4).Info("clusterrole resource already exists and is in expected state", "name", clusterRoleName)
-	}
-	if !exist {
-		if err := r.Create(r.ctx, obj); err != nil {
-			return common.FromClientError(err, "failed to create %s clusterrole resource", clusterRoleName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "clusterrole resource %s created", clusterRoleName)
-	}
-
-	return nil
+	return r.applyResource(esc, obj, &rbacv1.ClusterRole{}, "clusterrole", recon)
 }
 
 // getClusterRoleObject is for obtaining the content of given ClusterRole static asset, and
@@ -143,38 +109,7 @@ func (r *Reconciler) getClusterRoleObject(assetName string, resourceLabels map[s
 
 // createOrApplyClusterRoleBinding creates or updates given ClusterRoleBinding object.
 func (r *Reconciler) createOrApplyClusterRoleBinding(esc *operatorv1alpha1.ExternalSecretsConfig, obj *rbacv1.ClusterRoleBinding, recon bool) error {
-	var (
-		exist                  bool
-		err                    error
-		clusterRoleBindingName = obj.GetName()
-		fetched                = &rbacv1.ClusterRoleBinding{}
-	)
-	r.log.V(4).Info("reconciling clusterrolebinding resource", "name", clusterRoleBindingName)
-	exist, err = r.Exists(r.ctx, client.ObjectKeyFromObject(obj), fetched)
-	if err != nil {
-		return common.FromClientError(err, "failed to check %s clusterrolebinding resource already exists", clusterRoleBindingName)
-	}
-
-	if exist && recon {
-		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "%s clusterrolebinding resource already exists, maybe from previous installation", clusterRoleBindingName)
-	}
-	if exist && common.HasObjectChanged(obj, fetched) {
-		r.log.V(1).Info("clusterrolebinding has been modified, updating to desired state", "name", clusterRoleBindingName)
-		if err := r.UpdateWithRetry(r.ctx, obj); err != nil {
-			return common.FromClientError(err, "failed to update %s clusterrolebinding resource", clusterRoleBindingName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "clusterrolebinding resource %s reconciled back to desired state", clusterRoleBindingName)
-	} else {
-		r.log.V(4).Info("clusterrolebinding resource already exists and is in expected state", "name", clusterRoleBindingName)
-	}
-	if !exist {
-		if err := r.Create(r.ctx, obj); err != nil {
-			return common.FromClientError(err, "failed to create %s clusterrolebinding resource", clusterRoleBindingName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "clusterrolebinding resource %s created", clusterRoleBindingName)
-	}
-
-	return nil
+	return r.applyResource(esc, obj, &rbacv1.ClusterRoleBinding{}, "clusterrolebinding", recon)
 }
 
 // getClusterRoleBindingObject is for obtaining the content of given ClusterRoleBinding static asset, and
@@ -189,34 +124,7 @@ func (r *Reconciler) getClusterRoleBindingObject(esc *operatorv1alpha1.ExternalS
 
 // createOrApplyRole creates or updates given Role object.
 func (r *Reconciler) createOrApplyRole(esc *operatorv1alpha1.ExternalSecretsConfig, obj *rbacv1.Role, recon bool) error {
-	roleName := fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName())
-	r.log.V(4).Info("reconciling role resource", "name", roleName)
-	fetched := &rbacv1.Role{}
-	exist, err := r.Exists(r.ctx, client.ObjectKeyFromObject(obj), fetched)
-	if err != nil {
-		return common.FromClientError(err, "failed to check %s role resource already exists", roleName)
-	}
-
-	if exist && recon {
-		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "%s role resource already exists, maybe from previous installation", roleName)
-	}
-	if exist && common.HasObjectChanged(obj, fetched) {
-		r.log.V(1).Info("role has been modified, updating to desired state", "name", roleName)
-		if err := r.UpdateWithRetry(r.ctx, obj); err != nil {
-			return common.FromClientError(err, "failed to update %s role resource", roleName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "role resource %s reconciled back to desired state", roleName)
-	} else {
-		r.log.V(4).Info("role resource already exists and is in expected state", "name", roleName)
-	}
-	if !exist {
-		if err := r.Create(r.ctx, obj); err != nil {
-			return common.FromClientError(err, "failed to create %s role resource", roleName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "role resource %s created", roleName)
-	}
-
-	return nil
+	return r.applyResource(esc, obj, &rbacv1.Role{}, "role", recon)
 }
 
 // getRoleObject is for obtaining the content of given Role static asset, and
@@ -230,35 +138,7 @@ func (r *Reconciler) getRoleObject(esc *operatorv1alpha1.ExternalSecretsConfig,
 
 // createOrApplyRoleBinding creates or updates given RoleBinding object.
 func (r *Reconciler) createOrApplyRoleBinding(esc *operatorv1alpha1.ExternalSecretsConfig, obj *rbacv1.RoleBinding, recon bool) error {
-	roleBindingName := fmt.Sprintf("%s/%s", obj.GetNamespace(), obj.GetName())
-	r.log.V(4).Info("reconciling rolebinding resource", "name", roleBindingName)
-	fetched := &rbacv1.RoleBinding{}
-	exist, err := r.Exists(r.ctx, client.ObjectKeyFromObject(obj), fetched)
-	if err != nil {
-		return common.FromClientError(err, "failed to check %s rolebinding resource already exists", roleBindingName)
-	}
-
-	if exist && recon {
-		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "%s rolebinding resource already exists, maybe from previous installation", roleBindingName)
-	}
-	if exist && common.HasObjectChanged(obj, fetched) {
-		r.log.V(1).Info("rolebinding has been modified, updating to desired state", "name", roleBindingName)
-		if err := r.UpdateWithRetry(r.ctx, obj); err != nil {
-			return common.FromClientError(err, "failed to update %s rolebinding resource", roleBindingName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "rolebinding resource %s reconciled back to desired state", roleBindingName)
-	} else {
-		r.log.V(4).Info("rolebinding resource already exists and is in expected state", "name", roleBindingName)
-
-	}
-	if !exist {
-		if err := r.Create(r.ctx, obj); err != nil {
-			return common.FromClientError(err, "failed to create %s rolebinding resource", roleBindingName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "rolebinding resource %s created", roleBindingName)
-	}
-
-	return nil
+	return r.applyResource(esc, obj, &rbacv1.RoleBinding{}, "rolebinding", recon)
 }
 
 // getRoleBindingObject is for obtaining the content of given RoleBinding static asset, and
@@ -89,7 +89,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch obj.(type) {
 					case *rbacv1.ClusterRoleBinding:
 						return commontest.TestClientError
@@ -97,7 +97,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: `failed to update external-secrets-controller clusterrolebinding resource: test client error`,
+			wantErr: `failed to apply external-secrets-controller clusterrolebinding resource: test client error`,
 		},
 		{
 			name: "clusterrolebindings reconciliation updating to desired state successful",
@@ -123,7 +123,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch obj.(type) {
 					case *rbacv1.ClusterRoleBinding:
 						if obj.GetName() == testClusterRoleBinding(certControllerClusterRoleBindingAssetName).GetName() {
@@ -133,7 +133,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: `failed to create external-secrets-cert-controller clusterrolebinding resource: test client error`,
+			wantErr: `failed to apply external-secrets-cert-controller clusterrolebinding resource: test client error`,
 		},
 		{
 			name: "clusterrole reconciliation updating to desired state fails",
@@ -147,7 +147,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch obj.(type) {
 					case *rbacv1.ClusterRole:
 						return commontest.TestClientError
@@ -155,7 +155,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: `failed to update external-secrets-controller clusterrole resource: test client error`,
+			wantErr: `failed to apply external-secrets-controller clusterrole resource: test client error`,
 		},
 		{
 			name: "cert-controller clusterrole reconciliation creation fails",
@@ -167,7 +167,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch obj.(type) {
 					case *rbacv1.ClusterRole:
 						if obj.GetName() == testClusterRoleBinding(certControllerClusterRoleBindingAssetName).GetName() {
@@ -177,7 +177,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: `failed to create external-secrets-cert-controller clusterrole resource: test client error`,
+			wantErr: `failed to apply external-secrets-cert-controller clusterrole resource: test client error`,
 		},
 		{
 			name: "role reconciliation updating to desired state fails",
@@ -191,7 +191,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch obj.(type) {
 					case *rbacv1.Role:
 						return commontest.TestClientError
@@ -199,7 +199,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: `failed to update external-secrets/external-secrets-leaderelection role resource: test client error`,
+			wantErr: `failed to apply external-secrets/external-secrets-leaderelection role resource: test client error`,
 		},
 		{
 			name: "role reconciliation creation fails",
@@ -211,7 +211,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch obj.(type) {
 					case *rbacv1.Role:
 						return commontest.TestClientError
@@ -219,7 +219,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: `failed to create external-secrets/external-secrets-leaderelection role resource: test client error`,
+			wantErr: `failed to apply external-secrets/external-secrets-leaderelection role resource: test client error`,
 		},
 		{
 			name: "rolebindings reconciliation updating to desired state fails",
@@ -233,7 +233,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch obj.(type) {
 					case *rbacv1.RoleBinding:
 						return commontest.TestClientError
@@ -241,7 +241,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: `failed to update external-secrets/external-secrets-leaderelection rolebinding resource: test client error`,
+			wantErr: `failed to apply external-secrets/external-secrets-leaderelection rolebinding resource: test client error`,
 		},
 		{
 			name: "rolebindings reconciliation creation fails",
@@ -253,7 +253,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch obj.(type) {
 					case *rbacv1.RoleBinding:
 						return commontest.TestClientError
@@ -261,7 +261,7 @@ func TestCreateOrApplyRBACResource(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: `failed to create external-secrets/external-secrets-leaderelection rolebinding resource: test client error`,
+			wantErr: `failed to apply external-secrets/external-secrets-leaderelection rolebinding resource: test client error`,
 		},
 		{
 			name: "clusterroles creation successful",
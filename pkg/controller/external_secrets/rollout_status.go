@@ -0,0 +1,262 @@
+package external_secrets
+
+import (
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+	"github.com/openshift/external-secrets-operator/pkg/operator/assets"
+)
+
+const (
+	// progressDeadlineExceededReason is the reason set on the Deployment Progressing
+	// condition, when the rollout has not progressed within the deadline.
+	progressDeadlineExceededReason = "ProgressDeadlineExceeded"
+)
+
+// podFailureReasons are the container waiting reasons, which indicate the pod
+// cannot become ready without an intervention.
+var podFailureReasons = map[string]struct{}{
+	"ErrImagePull":               {},
+	"ImagePullBackOff":           {},
+	"InvalidImageName":           {},
+	"CrashLoopBackOff":           {},
+	"CreateContainerConfigError": {},
+	"CreateContainerError":       {},
+	"RunContainerError":          {},
+}
+
+// deploymentConditionTypes is the condition type reporting the availability of each of the operand deployments.
+var deploymentConditionTypes = map[string]string{
+	controllerDeploymentAssetName:     operatorv1alpha1.CoreControllerAvailable,
+	webhookDeploymentAssetName:        operatorv1alpha1.WebhookAvailable,
+	certControllerDeploymentAssetName: operatorv1alpha1.CertControllerAvailable,
+	bitwardenDeploymentAssetName:      operatorv1alpha1.BitwardenSDKServerAvailable,
+}
+
+// componentStatus is the observed state of an operand component, which is reported in its own condition.
+type componentStatus struct {
+	conditionType string
+	// pending holds the details of the workloads yet to become available.
+	pending []string
+	// reason is the reason the component is not available, which is either Progressing, or
+	// is indicating the rollout cannot complete without an intervention, like ImagePullBackOff.
+	reason string
+}
+
+// operandRolloutStatus is the observed state of the `external-secrets` operand workloads.
+type operandRolloutStatus struct {
+	components []*componentStatus
+}
+
+// isComplete returns whether all the operand workloads are available.
+func (s *operandRolloutStatus) isComplete() bool {
+	for _, c := range s.components {
+		if len(c.pending) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// isFailing returns whether any of the operand workloads cannot become available without an intervention.
+func (s *operandRolloutStatus) isFailing() bool {
+	for _, c := range s.components {
+		if len(c.pending) != 0 && c.reason != operatorv1alpha1.ReasonInProgress {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *operandRolloutStatus) message() string {
+	pending := make([]string, 0)
+	for _, c := range s.components {
+		pending = append(pending, c.pending...)
+	}
+	return strings.Join(pending, "; ")
+}
+
+// setConditions sets the availability condition of each of the operand components, and removes
+// the conditions of the components not required for the configuration.
+func (s *operandRolloutStatus) setConditions(conditions *[]metav1.Condition, generation int64) {
+	required := make(map[string]struct{}, len(s.components))
+	for _, c := range s.components {
+		required[c.conditionType] = struct{}{}
+		cond := metav1.Condition{
+			Type:               c.conditionType,
+			Status:             metav1.ConditionTrue,
+			Reason:             operatorv1alpha1.ReasonAvailable,
+			Message:            "available",
+			ObservedGeneration: generation,
+		}
+		if len(c.pending) != 0 {
+			cond.Status = metav1.ConditionFalse
+			cond.Reason = c.reason
+			cond.Message = strings.Join(c.pending, "; ")
+		}
+		apimeta.SetStatusCondition(conditions, cond)
+	}
+	for _, conditionType := range deploymentConditionTypes {
+		if _, ok := required[conditionType]; !ok {
+			apimeta.RemoveStatusCondition(conditions, conditionType)
+		}
+	}
+}
+
+// getOperandRolloutStatus returns the rollout state of the operand deployments, based on the deployment
+// status, and of the webhook service, based on the ready endpoints for it until first available.
+func (r *Reconciler) getOperandRolloutStatus(esc *operatorv1alpha1.ExternalSecretsConfig) (*operandRolloutStatus, error) {
+	status := &operandRolloutStatus{}
+
+	for _, assetName := range getDeploymentAssetNames(esc) {
+		deployment := common.DecodeDeploymentObjBytes(assets.MustAsset(assetName))
+		updateNamespace(deployment, esc)
+		deploymentName := fmt.Sprintf("%s/%s", deployment.GetNamespace(), deployment.GetName())
+		component := &componentStatus{
+			conditionType: deploymentConditionTypes[assetName],
+			reason:        operatorv1alpha1.ReasonInProgress,
+		}
+		status.components = append(status.components, component)
+
+		fetched := &appsv1.Deployment{}
+		exist, err := r.Exists(r.ctx, client.ObjectKeyFromObject(deployment), fetched)
+		if err != nil {
+			return nil, common.FromClientError(err, "failed to fetch %s deployment resource", deploymentName)
+		}
+		if !exist {
+			component.pending = append(component.pending, fmt.Sprintf("deployment %s does not exist", deploymentName))
+			continue
+		}
+
+		msg, failed := deploymentRolloutStatus(fetched)
+		if msg == "" {
+			continue
+		}
+		if failed {
+			component.reason = operatorv1alpha1.ReasonFailed
+		}
+		podReason, podMsg, err := r.getPodFailureReason(fetched)
+		if err != nil {
+			return nil, err
+		}
+		if podReason != "" {
+			msg = fmt.Sprintf("%s, %s", msg, podMsg)
+			component.reason = podReason
+		}
+		component.pending = append(component.pending, fmt.Sprintf("deployment %s: %s", deploymentName, msg))
+	}
+
+	// webhook endpoints are checked only once the rollout of the webhook deployment is complete, and till
+	// the webhook is observed available, for not listing the EndpointSlices on every reconciliation. The
+	// endpoints becoming not ready afterward is reported by the deployment availability.
+	for _, c := range status.components {
+		if c.conditionType != operatorv1alpha1.WebhookAvailable || len(c.pending) != 0 ||
+			apimeta.IsStatusConditionTrue(esc.Status.Conditions, operatorv1alpha1.WebhookAvailable) {
+			continue
+		}
+		service := common.DecodeServiceObjBytes(assets.MustAsset(webhookServiceAssetName))
+		updateNamespace(service, esc)
+		ready, err := r.hasReadyEndpoints(service)
+		if err != nil {
+			return nil, err
+		}
+		if !ready {
+			c.pending = append(c.pending, fmt.Sprintf("service %s/%s does not have ready endpoints", service.GetNamespace(), service.GetName()))
+		}
+	}
+
+	return status, nil
+}
+
+// deploymentRolloutStatus returns the details of the deployment rollout in progress, and is empty when
+// the rollout is complete. Also returns whether the rollout failed to progress within the deadline.
+func deploymentRolloutStatus(d *appsv1.Deployment) (string, bool) {
+	if d.GetGeneration() > d.Status.ObservedGeneration {
+		return "waiting for the deployment spec update to be observed", false
+	}
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing && cond.Reason == progressDeadlineExceededReason {
+			return fmt.Sprintf("rollout exceeded its progress deadline: %s", cond.Message), true
+		}
+	}
+
+	replicas := ptr.Deref(d.Spec.Replicas, 1)
+	switch {
+	case d.Status.UpdatedReplicas < replicas:
+		return fmt.Sprintf("%d out of %d new replicas have been updated", d.Status.UpdatedReplicas, replicas), false
+	case d.Status.Replicas > d.Status.UpdatedReplicas:
+		return fmt.Sprintf("%d old replicas are pending termination", d.Status.Replicas-d.Status.UpdatedReplicas), false
+	case d.Status.AvailableReplicas < d.Status.UpdatedReplicas:
+		return fmt.Sprintf("%d of %d updated replicas are available", d.Status.AvailableReplicas, d.Status.UpdatedReplicas), false
+	}
+	return "", false
+}
+
+// getPodFailureReason returns the reason reported for the deployment pods, which is preventing them from
+// becoming ready, along with the details. Pods are not cached by the controller, and are read only while
+// the deployment rollout is in progress, hence the uncached client is used.
+func (r *Reconciler) getPodFailureReason(d *appsv1.Deployment) (string, string, error) {
+	if d.Spec.Selector == nil || len(d.Spec.Selector.MatchLabels) == 0 {
+		return "", "", nil
+	}
+	pods := &corev1.PodList{}
+	if err := r.UncachedClient.List(r.ctx, pods, client.InNamespace(d.GetNamespace()), client.MatchingLabels(d.Spec.Selector.MatchLabels)); err != nil {
+		return "", "", common.FromClientError(err, "failed to list pods of %s/%s deployment", d.GetNamespace(), d.GetName())
+	}
+
+	for _, pod := range pods.Items {
+		if reason, msg := podFailureReason(&pod); reason != "" {
+			return reason, fmt.Sprintf("pod %s %s", pod.GetName(), msg), nil
+		}
+	}
+	return "", "", nil
+}
+
+// podFailureReason returns the reason a pod cannot become ready along with the details, and
+// is empty when none is found.
+func podFailureReason(pod *corev1.Pod) (string, string) {
+	statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	for _, cs := range statuses {
+		if cs.State.Waiting == nil {
+			continue
+		}
+		if _, ok := podFailureReasons[cs.State.Waiting.Reason]; ok {
+			return cs.State.Waiting.Reason, fmt.Sprintf("container %s is waiting: %s: %s", cs.Name, cs.State.Waiting.Reason, cs.State.Waiting.Message)
+		}
+	}
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == corev1.PodScheduled && cond.Status == corev1.ConditionFalse && cond.Reason == corev1.PodReasonUnschedulable {
+			return cond.Reason, fmt.Sprintf("is not scheduled: %s: %s", cond.Reason, cond.Message)
+		}
+	}
+	return "", ""
+}
+
+// hasReadyEndpoints returns whether the service has at least one ready endpoint. EndpointSlices
+// are not cached by the controller, and are read only till the webhook is first available, hence
+// the uncached client is used.
+func (r *Reconciler) hasReadyEndpoints(service *corev1.Service) (bool, error) {
+	slices := &discoveryv1.EndpointSliceList{}
+	if err := r.UncachedClient.List(r.ctx, slices, client.InNamespace(service.GetNamespace()), client.MatchingLabels{discoveryv1.LabelServiceName: service.GetName()}); err != nil {
+		return false, common.FromClientError(err, "failed to list endpointslices of %s/%s service", service.GetNamespace(), service.GetName())
+	}
+
+	for _, slice := range slices.Items {
+		for _, endpoint := range slice.Endpoints {
+			if ptr.Deref(endpoint.Conditions.Ready, true) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,314 @@
+package external_secrets
+
+import (
+	"context"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+)
+
+// testDeploymentWithStatus returns a Deployment with the given replicas count in status.
+func testDeploymentWithStatus(name string, updated, available int32) *appsv1.Deployment {
+	d := testDeployment(name)
+	d.Spec.Replicas = ptr.To(int32(1))
+	d.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"app.kubernetes.io/name": name}}
+	d.Status = appsv1.DeploymentStatus{
+		Replicas:          updated,
+		UpdatedReplicas:   updated,
+		AvailableReplicas: available,
+	}
+	return d
+}
+
+func testPodWaiting(reason string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "external-secrets-7d9f"},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{
+				{
+					Name: "external-secrets",
+					State: corev1.ContainerState{
+						Waiting: &corev1.ContainerStateWaiting{Reason: reason, Message: "test message"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestDeploymentRolloutStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		deployment func() *appsv1.Deployment
+		wantMsg    string
+		wantFailed bool
+	}{
+		{
+			name:       "rollout complete",
+			deployment: func() *appsv1.Deployment { return testDeploymentWithStatus("external-secrets", 1, 1) },
+		},
+		{
+			name: "spec update not observed",
+			deployment: func() *appsv1.Deployment {
+				d := testDeploymentWithStatus("external-secrets", 1, 1)
+				d.SetGeneration(2)
+				d.Status.ObservedGeneration = 1
+				return d
+			},
+			wantMsg: "waiting for the deployment spec update to be observed",
+		},
+		{
+			name: "progress deadline exceeded",
+			deployment: func() *appsv1.Deployment {
+				d := testDeploymentWithStatus("external-secrets", 1, 0)
+				d.Status.Conditions = []appsv1.DeploymentCondition{
+					{Type: appsv1.DeploymentProgressing, Status: corev1.ConditionFalse, Reason: progressDeadlineExceededReason, Message: "timed out"},
+				}
+				return d
+			},
+			wantMsg:    "rollout exceeded its progress deadline: timed out",
+			wantFailed: true,
+		},
+		{
+			name:       "new replicas not updated",
+			deployment: func() *appsv1.Deployment { return testDeploymentWithStatus("external-secrets", 0, 0) },
+			wantMsg:    "0 out of 1 new replicas have been updated",
+		},
+		{
+			name: "old replicas pending termination",
+			deployment: func() *appsv1.Deployment {
+				d := testDeploymentWithStatus("external-secrets", 1, 1)
+				d.Status.Replicas = 2
+				return d
+			},
+			wantMsg: "1 old replicas are pending termination",
+		},
+		{
+			name:       "updated replicas not available",
+			deployment: func() *appsv1.Deployment { return testDeploymentWithStatus("external-secrets", 1, 0) },
+			wantMsg:    "0 of 1 updated replicas are available",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, failed := deploymentRolloutStatus(tt.deployment())
+			if msg != tt.wantMsg || failed != tt.wantFailed {
+				t.Errorf("deploymentRolloutStatus() got: (%q, %v), want: (%q, %v)", msg, failed, tt.wantMsg, tt.wantFailed)
+			}
+		})
+	}
+}
+
+func TestPodFailureReason(t *testing.T) {
+	tests := []struct {
+		name       string
+		pod        *corev1.Pod
+		wantReason string
+		want       string
+	}{
+		{
+			name:       "image pull back off",
+			pod:        testPodWaiting("ImagePullBackOff"),
+			wantReason: "ImagePullBackOff",
+			want:       "container external-secrets is waiting: ImagePullBackOff: test message",
+		},
+		{
+			name: "container creating is not a failure",
+			pod:  testPodWaiting("ContainerCreating"),
+		},
+		{
+			name: "unschedulable pod",
+			pod: &corev1.Pod{
+				Status: corev1.PodStatus{
+					Conditions: []corev1.PodCondition{
+						{Type: corev1.PodScheduled, Status: corev1.ConditionFalse, Reason: corev1.PodReasonUnschedulable, Message: "0/3 nodes are available"},
+					},
+				},
+			},
+			wantReason: corev1.PodReasonUnschedulable,
+			want:       "is not scheduled: Unschedulable: 0/3 nodes are available",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason, got := podFailureReason(tt.pod)
+			if reason != tt.wantReason || got != tt.want {
+				t.Errorf("podFailureReason() got: (%q, %q), want: (%q, %q)", reason, got, tt.wantReason, tt.want)
+			}
+		})
+	}
+}
+
+func TestGetOperandRolloutStatus(t *testing.T) {
+	readyEndpoints := func(list *discoveryv1.EndpointSliceList) {
+		list.Items = []discoveryv1.EndpointSlice{
+			{Endpoints: []discoveryv1.Endpoint{{Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)}}}},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		available int32
+		pod       *corev1.Pod
+		endpoints func(*discoveryv1.EndpointSliceList)
+		existsErr error
+		// webhookAvailable is whether the webhook was observed available in the previous reconciliation.
+		webhookAvailable    bool
+		wantEndpointsListed bool
+		wantComplete        bool
+		wantFailed          bool
+		wantMsg             string
+		wantErr             string
+	}{
+		{
+			name:                "all workloads available",
+			available:           1,
+			endpoints:           readyEndpoints,
+			wantEndpointsListed: true,
+			wantComplete:        true,
+		},
+		{
+			name:             "webhook endpoints not checked once available",
+			available:        1,
+			webhookAvailable: true,
+			wantComplete:     true,
+		},
+		{
+			name:      "rollout in progress",
+			pod:       testPodWaiting("ContainerCreating"),
+			endpoints: readyEndpoints,
+			wantMsg: "deployment external-secrets/external-secrets: 0 of 1 updated replicas are available; " +
+				"deployment external-secrets/external-secrets-webhook: 0 of 1 updated replicas are available; " +
+				"deployment external-secrets/external-secrets-cert-controller: 0 of 1 updated replicas are available",
+		},
+		{
+			name:      "image cannot be pulled",
+			pod:       testPodWaiting("ImagePullBackOff"),
+			endpoints: readyEndpoints,
+			wantMsg: "deployment external-secrets/external-secrets: 0 of 1 updated replicas are available, pod external-secrets-7d9f container external-secrets is waiting: ImagePullBackOff: test message; " +
+				"deployment external-secrets/external-secrets-webhook: 0 of 1 updated replicas are available, pod external-secrets-7d9f container external-secrets is waiting: ImagePullBackOff: test message; " +
+				"deployment external-secrets/external-secrets-cert-controller: 0 of 1 updated replicas are available, pod external-secrets-7d9f container external-secrets is waiting: ImagePullBackOff: test message",
+			wantFailed: true,
+		},
+		{
+			name:      "webhook service without ready endpoints",
+			available: 1,
+			endpoints: func(list *discoveryv1.EndpointSliceList) {
+				list.Items = []discoveryv1.EndpointSlice{
+					{Endpoints: []discoveryv1.Endpoint{{Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)}}}},
+				}
+			},
+			wantEndpointsListed: true,
+			wantMsg:             "service external-secrets/external-secrets-webhook does not have ready endpoints",
+		},
+		{
+			name:      "fetching deployment fails",
+			existsErr: commontest.TestClientError,
+			wantErr:   "failed to fetch external-secrets/external-secrets deployment resource: test client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			r.UncachedClient = mock
+			mock.ExistsCalls(func(ctx context.Context, key client.ObjectKey, obj client.Object) (bool, error) {
+				if tt.existsErr != nil {
+					return false, tt.existsErr
+				}
+				testDeploymentWithStatus(key.Name, 1, tt.available).DeepCopyInto(obj.(*appsv1.Deployment))
+				return true, nil
+			})
+			mock.ListCalls(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+				switch l := list.(type) {
+				case *corev1.PodList:
+					if tt.pod != nil {
+						l.Items = []corev1.Pod{*tt.pod}
+					}
+				case *discoveryv1.EndpointSliceList:
+					if tt.endpoints != nil {
+						tt.endpoints(l)
+					}
+				}
+				return nil
+			})
+
+			esc := commontest.TestExternalSecretsConfig()
+			if tt.webhookAvailable {
+				esc.Status.Conditions = []metav1.Condition{{Type: operatorv1alpha1.WebhookAvailable, Status: metav1.ConditionTrue, Reason: operatorv1alpha1.ReasonAvailable}}
+			}
+
+			status, err := r.getOperandRolloutStatus(esc)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Fatalf("getOperandRolloutStatus() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if status.isComplete() != tt.wantComplete || status.isFailing() != tt.wantFailed {
+				t.Errorf("getOperandRolloutStatus() complete: %v, failing: %v, want: %v, %v", status.isComplete(), status.isFailing(), tt.wantComplete, tt.wantFailed)
+			}
+			if got := status.message(); got != tt.wantMsg {
+				t.Errorf("getOperandRolloutStatus() message: %q, want: %q", got, tt.wantMsg)
+			}
+			endpointsListed := false
+			for i := range mock.ListCallCount() {
+				_, list, _ := mock.ListArgsForCall(i)
+				if _, ok := list.(*discoveryv1.EndpointSliceList); ok {
+					endpointsListed = true
+				}
+			}
+			if endpointsListed != tt.wantEndpointsListed {
+				t.Errorf("getOperandRolloutStatus() endpointslices listed: %v, want: %v", endpointsListed, tt.wantEndpointsListed)
+			}
+		})
+	}
+}
+
+func TestOperandRolloutStatusSetConditions(t *testing.T) {
+	status := &operandRolloutStatus{
+		components: []*componentStatus{
+			{conditionType: operatorv1alpha1.CoreControllerAvailable, reason: operatorv1alpha1.ReasonInProgress},
+			{conditionType: operatorv1alpha1.WebhookAvailable, reason: "ImagePullBackOff", pending: []string{"deployment external-secrets/external-secrets-webhook: image cannot be pulled"}},
+		},
+	}
+	conditions := []metav1.Condition{
+		{Type: operatorv1alpha1.Ready, Status: metav1.ConditionTrue, Reason: operatorv1alpha1.ReasonReady},
+		{Type: operatorv1alpha1.BitwardenSDKServerAvailable, Status: metav1.ConditionTrue, Reason: operatorv1alpha1.ReasonAvailable},
+	}
+
+	status.setConditions(&conditions, 1)
+
+	if cond := apimeta.FindStatusCondition(conditions, operatorv1alpha1.CoreControllerAvailable); cond == nil ||
+		cond.Status != metav1.ConditionTrue || cond.Reason != operatorv1alpha1.ReasonAvailable {
+		t.Errorf("setConditions() %s condition: %+v", operatorv1alpha1.CoreControllerAvailable, cond)
+	}
+	if cond := apimeta.FindStatusCondition(conditions, operatorv1alpha1.WebhookAvailable); cond == nil ||
+		cond.Status != metav1.ConditionFalse || cond.Reason != "ImagePullBackOff" ||
+		cond.Message != "deployment external-secrets/external-secrets-webhook: image cannot be pulled" {
+		t.Errorf("setConditions() %s condition: %+v", operatorv1alpha1.WebhookAvailable, cond)
+	}
+	if cond := apimeta.FindStatusCondition(conditions, operatorv1alpha1.BitwardenSDKServerAvailable); cond != nil {
+		t.Errorf("setConditions() condition of the component not required must be removed: %+v", cond)
+	}
+	if cond := apimeta.FindStatusCondition(conditions, operatorv1alpha1.Ready); cond == nil {
+		t.Errorf("setConditions() conditions other than of the components must be retained")
+	}
+	if !status.isFailing() || status.isComplete() {
+		t.Errorf("setConditions() rollout must be failing: %v, complete: %v", status.isFailing(), status.isComplete())
+	}
+}
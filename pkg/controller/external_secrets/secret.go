@@ -1,9 +1,15 @@
 package external_secrets
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
@@ -12,54 +18,175 @@ import (
 )
 
 func (r *Reconciler) createOrApplySecret(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string, recon bool) error {
+	desired, err := r.getSecretObject(esc, resourceLabels)
+	if err != nil {
+		return fmt.Errorf("failed to generate secret resource for creation: %w", err)
+	}
+
 	// secrets are only created if isCertManagerConfig is not enabled
 	if isCertManagerConfigEnabled(esc) {
 		r.log.V(4).Info("cert-manager config is enabled, skipping webhook component secret resource creation")
+		// the secret of same name is populated by cert-manager, which must not be pruned.
+		r.inventory.add(desired)
 		return nil
 	}
 
-	desired, err := r.getSecretObject(esc, resourceLabels)
-	if err != nil {
-		return fmt.Errorf("failed to generate secret resource for creation: %w", err)
+	// the serving certificate is generated by service-ca, or provided by the user, in a secret of different
+	// name, hence the secret not being added to the inventory for it to be pruned.
+	if common.IsServiceCAConfigEnabled(esc) || getWebhookCertificateSecretRef(esc) != nil {
+		r.log.V(4).Info("service-ca config or webhook certificate secret is configured, skipping webhook component secret resource creation")
+		return nil
 	}
 
-	secretName := fmt.Sprintf("%s/%s", desired.GetNamespace(), desired.GetName())
-	r.log.V(4).Info("reconciling secret resource", "name", secretName)
-	fetched := &corev1.Secret{}
+	// certificates are populated in the data by the cert-controller, which is not owned by the operator.
+	return r.applyResource(esc, desired, &corev1.Secret{}, "secret", recon)
+}
 
-	exist, err := r.Exists(r.ctx, client.ObjectKeyFromObject(desired), fetched)
-	if err != nil {
-		return common.FromClientError(err, "failed to check %s secret resource already exists", secretName)
-	}
+func (r *Reconciler) getSecretObject(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string) (*corev1.Secret, error) {
+	secret := common.DecodeSecretObjBytes(assets.MustAsset(webhookTLSSecretAssetName))
 
-	if exist && recon {
-		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "%s secret resource already exists, maybe from previous installation", secretName)
-	}
+	updateNamespace(secret, esc)
+	common.UpdateResourceLabels(secret, resourceLabels)
+	return secret, nil
+}
 
-	if exist && common.ObjectMetadataModified(desired, fetched) {
-		r.log.V(1).Info("secret has been modified, updating to desired state", "name", secretName)
-		if err := r.UpdateWithRetry(r.ctx, desired); err != nil {
-			return common.FromClientError(err, "failed to update %s secret resource", secretName)
+// userCertificate is the certificate read from a user provided TLS secret.
+type userCertificate struct {
+	component string
+	key       client.ObjectKey
+	cert      *x509.Certificate
+	caBundle  []byte
+}
+
+// getUserCertificates returns the certificates from the TLS secrets provided by the user for the webhook
+// and the bitwarden-sdk-server, which are not managed by any of the certificate providers.
+func (r *Reconciler) getUserCertificates(esc *operatorv1alpha1.ExternalSecretsConfig) ([]userCertificate, error) {
+	var certs []userCertificate
+	if ref := getWebhookCertificateSecretRef(esc); ref != nil {
+		cert, err := r.getUserCertificate(esc, "webhook", ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		dnsName := fmt.Sprintf("%s.%s.svc", serviceExternalSecretWebhookName, getNamespace(esc))
+		if err := cert.cert.VerifyHostname(dnsName); err != nil {
+			return nil, common.NewRetryRequiredError(err, "invalid webhook certificate in %s secret", cert.key).WithCause(common.InvalidConfiguration)
+		}
+		certs = append(certs, *cert)
+	}
+	if isBitwardenConfigEnabled(esc) && isBitwardenSecretRefConfigured(esc) {
+		cert, err := r.getUserCertificate(esc, "bitwarden-sdk-server", esc.Spec.Plugins.BitwardenSecretManagerProvider.SecretRef.Name)
+		if err != nil {
+			return nil, err
 		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "secret resource %s reconciled back to desired state", secretName)
-	} else {
-		r.log.V(4).Info("secret resource already exists and is in expected state", "name", secretName)
+		certs = append(certs, *cert)
 	}
+	return certs, nil
+}
 
+// getUserCertificate reads and validates the certificate from the user provided TLS secret. The secret is
+// not created by the controller, hence the uncached client is used for reading it. The errors are retried,
+// as the changes to the secret are not watched but checked at every userCertificateCheckInterval.
+func (r *Reconciler) getUserCertificate(esc *operatorv1alpha1.ExternalSecretsConfig, component, secretName string) (*userCertificate, error) {
+	key := client.ObjectKey{Namespace: getNamespace(esc), Name: secretName}
+	secret := &corev1.Secret{}
+	exist, err := r.UncachedClient.Exists(r.ctx, key, secret)
+	if err != nil {
+		return nil, common.FromClientError(err, "failed to fetch %s %s certificate secret", key, component)
+	}
 	if !exist {
-		if err := r.Create(r.ctx, desired); err != nil {
-			return common.FromClientError(err, "failed to create %s secret resource", secretName)
+		return nil, common.NewRetryRequiredError(fmt.Errorf("secret does not exist"), "failed to fetch %s %s certificate secret", key, component)
+	}
+
+	for _, k := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey, caCertificateKey} {
+		if len(secret.Data[k]) == 0 {
+			return nil, common.NewRetryRequiredError(fmt.Errorf("%q key does not exist", k), "failed to read %s certificate from %s secret", component, key).WithCause(common.InvalidConfiguration)
 		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "secret resource %s created", secretName)
 	}
-	return nil
+	block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, common.NewRetryRequiredError(fmt.Errorf("no PEM encoded certificate found in %q key", corev1.TLSCertKey), "invalid %s certificate in %s secret", component, key).WithCause(common.InvalidConfiguration)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, common.NewRetryRequiredError(fmt.Errorf("failed to parse certificate: %w", err), "invalid %s certificate in %s secret", component, key).WithCause(common.InvalidConfiguration)
+	}
+	if err := validateCABundle(secret.Data[caCertificateKey]); err != nil {
+		return nil, common.NewRetryRequiredError(err, "invalid %s CA certificate in %s secret", component, key).WithCause(common.InvalidConfiguration)
+	}
 
+	return &userCertificate{
+		component: component,
+		key:       key,
+		cert:      cert,
+		caBundle:  secret.Data[caCertificateKey],
+	}, nil
 }
 
-func (r *Reconciler) getSecretObject(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string) (*corev1.Secret, error) {
-	secret := common.DecodeSecretObjBytes(assets.MustAsset(webhookTLSSecretAssetName))
+// getWebhookCABundle returns the CA certificates from the user provided webhook certificate secret, which
+// is set as the caBundle in the ValidatingWebhookConfigurations, and is nil when not configured.
+func (r *Reconciler) getWebhookCABundle(esc *operatorv1alpha1.ExternalSecretsConfig) ([]byte, error) {
+	provider := getWebhookCertificateProvider(esc)
+	if provider.Provider != operatorv1alpha1.UserProvidedProvider {
+		return nil, nil
+	}
+	cert, err := r.getUserCertificate(esc, "webhook", provider.SecretName)
+	if err != nil {
+		return nil, err
+	}
+	return cert.caBundle, nil
+}
 
-	updateNamespace(secret, esc)
-	common.UpdateResourceLabels(secret, resourceLabels)
-	return secret, nil
+// setCertificateExpiringCondition sets the CertificateExpiring condition, and emits a warning event when a
+// certificate is newly found to be expiring. The condition is removed when nil.
+func (r *Reconciler) setCertificateExpiringCondition(esc *operatorv1alpha1.ExternalSecretsConfig, cond *metav1.Condition) {
+	if cond == nil {
+		apimeta.RemoveStatusCondition(&esc.Status.Conditions, operatorv1alpha1.CertificateExpiring)
+		return
+	}
+	if prev := apimeta.FindStatusCondition(esc.Status.Conditions, cond.Type); cond.Status == metav1.ConditionTrue &&
+		(prev == nil || prev.Message != cond.Message) {
+		r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "CertificateExpiring", "%s", cond.Message)
+	}
+	apimeta.SetStatusCondition(&esc.Status.Conditions, *cond)
+}
+
+// getCertificateExpiringCondition returns the condition indicating whether any of the user provided
+// certificates expires within the certificateExpiryWarningWindow, and is nil when none is configured.
+func (r *Reconciler) getCertificateExpiringCondition(esc *operatorv1alpha1.ExternalSecretsConfig) (*metav1.Condition, error) {
+	certs, err := r.getUserCertificates(esc)
+	if err != nil || len(certs) == 0 {
+		return nil, err
+	}
+
+	cond := &metav1.Condition{
+		Type:               operatorv1alpha1.CertificateExpiring,
+		Status:             metav1.ConditionFalse,
+		Reason:             operatorv1alpha1.ReasonValid,
+		ObservedGeneration: esc.GetGeneration(),
+	}
+	now := time.Now()
+	expiringBefore := now.Add(getCertificateExpiryWarningWindow(esc))
+	var valid, expiring []string
+	for _, c := range certs {
+		notAfter := c.cert.NotAfter.UTC().Format(time.RFC3339)
+		switch {
+		case now.After(c.cert.NotAfter):
+			cond.Reason = operatorv1alpha1.ReasonExpired
+			expiring = append(expiring, fmt.Sprintf("%s certificate in %s secret expired at %s", c.component, c.key, notAfter))
+		case expiringBefore.After(c.cert.NotAfter):
+			if cond.Reason != operatorv1alpha1.ReasonExpired {
+				cond.Reason = operatorv1alpha1.ReasonExpiring
+			}
+			expiring = append(expiring, fmt.Sprintf("%s certificate in %s secret expires at %s", c.component, c.key, notAfter))
+		default:
+			valid = append(valid, fmt.Sprintf("%s certificate in %s secret expires at %s", c.component, c.key, notAfter))
+		}
+	}
+	if len(expiring) != 0 {
+		cond.Status = metav1.ConditionTrue
+		cond.Message = strings.Join(expiring, ", ")
+		return cond, nil
+	}
+	cond.Message = strings.Join(valid, ", ")
+	return cond, nil
 }
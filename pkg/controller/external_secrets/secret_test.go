@@ -2,15 +2,25 @@ package external_secrets
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
 	"fmt"
+	"math/big"
 	"testing"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
 	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
 )
 
@@ -108,7 +118,7 @@ func TestCreateOrApplySecret(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch obj.(type) {
 					case *corev1.Secret:
 						return commontest.TestClientError
@@ -116,7 +126,7 @@ func TestCreateOrApplySecret(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: fmt.Sprintf("failed to update %s/%s secret resource: %s", commontest.TestExternalSecretsNamespace, testValidateSecretResourceName, commontest.TestClientError),
+			wantErr: fmt.Sprintf("failed to apply %s/%s secret resource: %s", commontest.TestExternalSecretsNamespace, testValidateSecretResourceName, commontest.TestClientError),
 		},
 		{
 			name: "reconciliation of secret which already exists in expected state",
@@ -157,7 +167,7 @@ func TestCreateOrApplySecret(t *testing.T) {
 					}
 					return true, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch obj.(type) {
 					case *corev1.Secret:
 						return commontest.TestClientError
@@ -165,7 +175,7 @@ func TestCreateOrApplySecret(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: fmt.Sprintf("failed to create %s/%s secret resource: %s", commontest.TestExternalSecretsNamespace, testValidateSecretResourceName, commontest.TestClientError),
+			wantErr: fmt.Sprintf("failed to apply %s/%s secret resource: %s", commontest.TestExternalSecretsNamespace, testValidateSecretResourceName, commontest.TestClientError),
 		},
 		{
 			name: "successful secret creation",
@@ -174,7 +184,7 @@ func TestCreateOrApplySecret(t *testing.T) {
 					return false, nil
 				})
 
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					return nil
 				})
 			},
@@ -184,6 +194,34 @@ func TestCreateOrApplySecret(t *testing.T) {
 			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
 			},
 		},
+		{
+			name: "secret creation skipped when service-ca config is enabled",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					t.Errorf("unexpected apply of %s secret with service-ca enabled", obj.GetName())
+					return nil
+				})
+			},
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider.ServiceCA = &v1alpha1.ServiceCAConfig{
+					Mode: v1alpha1.Enabled,
+				}
+			},
+		},
+		{
+			name: "secret creation skipped when webhook certificate secret is configured",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+					t.Errorf("unexpected apply of %s secret with webhook certificate secret configured", obj.GetName())
+					return nil
+				})
+			},
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ApplicationConfig.WebhookConfig = &v1alpha1.WebhookConfig{
+					CertificateSecretRef: &v1alpha1.SecretReference{Name: "webhook-tls"},
+				}
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -222,3 +260,224 @@ func testExternalSecretsConfigForSecrets() *v1alpha1.ExternalSecretsConfig {
 	}
 	return esc
 }
+
+// testTLSSecret returns a TLS secret with a self-signed certificate for the DNS name, expiring at notAfter,
+// which is also used as the CA certificate.
+func testTLSSecret(t *testing.T, name, dnsName string, notAfter time.Time) *corev1.Secret {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		NotBefore:    notAfter.Add(-365 * 24 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: commontest.TestExternalSecretsNamespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       cert,
+			corev1.TLSPrivateKeyKey: pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+			caCertificateKey:        cert,
+		},
+	}
+}
+
+func TestGetCertificateExpiringCondition(t *testing.T) {
+	const (
+		webhookDNSName = "external-secrets-webhook.external-secrets.svc"
+		webhookSecret  = "webhook-tls"
+		bitwardenName  = "bitwarden-tls"
+	)
+	expiresAt := func(d time.Duration) time.Time {
+		return time.Now().Add(d).Truncate(time.Second)
+	}
+	webhookCert := func(esc *v1alpha1.ExternalSecretsConfig) {
+		esc.Spec.ApplicationConfig.WebhookConfig = &v1alpha1.WebhookConfig{
+			CertificateSecretRef: &v1alpha1.SecretReference{Name: webhookSecret},
+		}
+	}
+	bitwardenCert := func(esc *v1alpha1.ExternalSecretsConfig) {
+		esc.Spec.Plugins.BitwardenSecretManagerProvider = &v1alpha1.BitwardenSecretManagerProvider{
+			Mode:      v1alpha1.Enabled,
+			SecretRef: &v1alpha1.SecretReference{Name: bitwardenName},
+		}
+	}
+	notAfter := map[string]time.Time{}
+
+	tests := []struct {
+		name        string
+		esc         func(*v1alpha1.ExternalSecretsConfig)
+		secrets     func(t *testing.T) []*corev1.Secret
+		wantCond    *metav1.Condition
+		wantErr     string
+		wantCause   common.ErrorCause
+		wantMessage func() string
+	}{
+		{
+			name: "no user provided certificates",
+		},
+		{
+			name: "webhook certificate valid",
+			esc:  webhookCert,
+			secrets: func(t *testing.T) []*corev1.Secret {
+				notAfter[webhookSecret] = expiresAt(60 * 24 * time.Hour)
+				return []*corev1.Secret{testTLSSecret(t, webhookSecret, webhookDNSName, notAfter[webhookSecret])}
+			},
+			wantCond: &metav1.Condition{Status: metav1.ConditionFalse, Reason: v1alpha1.ReasonValid},
+			wantMessage: func() string {
+				return "webhook certificate in external-secrets/webhook-tls secret expires at " + notAfter[webhookSecret].UTC().Format(time.RFC3339)
+			},
+		},
+		{
+			name: "webhook certificate within configured window",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				webhookCert(esc)
+				esc.Spec.ApplicationConfig.CertificateExpiryWarningWindow = &metav1.Duration{Duration: 90 * 24 * time.Hour}
+			},
+			secrets: func(t *testing.T) []*corev1.Secret {
+				notAfter[webhookSecret] = expiresAt(60 * 24 * time.Hour)
+				return []*corev1.Secret{testTLSSecret(t, webhookSecret, webhookDNSName, notAfter[webhookSecret])}
+			},
+			wantCond: &metav1.Condition{Status: metav1.ConditionTrue, Reason: v1alpha1.ReasonExpiring},
+		},
+		{
+			name: "bitwarden certificate expiring and webhook certificate expired",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				webhookCert(esc)
+				bitwardenCert(esc)
+			},
+			secrets: func(t *testing.T) []*corev1.Secret {
+				notAfter[webhookSecret] = expiresAt(-time.Hour)
+				notAfter[bitwardenName] = expiresAt(24 * time.Hour)
+				return []*corev1.Secret{
+					testTLSSecret(t, webhookSecret, webhookDNSName, notAfter[webhookSecret]),
+					testTLSSecret(t, bitwardenName, "bitwarden-sdk-server.external-secrets.svc.cluster.local", notAfter[bitwardenName]),
+				}
+			},
+			wantCond: &metav1.Condition{Status: metav1.ConditionTrue, Reason: v1alpha1.ReasonExpired},
+			wantMessage: func() string {
+				return "webhook certificate in external-secrets/webhook-tls secret expired at " + notAfter[webhookSecret].UTC().Format(time.RFC3339) +
+					", bitwarden-sdk-server certificate in external-secrets/bitwarden-tls secret expires at " + notAfter[bitwardenName].UTC().Format(time.RFC3339)
+			},
+		},
+		{
+			name: "bitwarden certificate not checked when plugin disabled",
+			esc: func(esc *v1alpha1.ExternalSecretsConfig) {
+				bitwardenCert(esc)
+				esc.Spec.Plugins.BitwardenSecretManagerProvider.Mode = v1alpha1.Disabled
+			},
+		},
+		{
+			name: "webhook certificate not valid for webhook service",
+			esc:  webhookCert,
+			secrets: func(t *testing.T) []*corev1.Secret {
+				return []*corev1.Secret{testTLSSecret(t, webhookSecret, "webhook.example.com", expiresAt(time.Hour))}
+			},
+			wantErr:   "invalid webhook certificate in external-secrets/webhook-tls secret: x509: certificate is valid for webhook.example.com, not external-secrets-webhook.external-secrets.svc",
+			wantCause: common.InvalidConfiguration,
+		},
+		{
+			name: "webhook certificate secret without CA certificate",
+			esc:  webhookCert,
+			secrets: func(t *testing.T) []*corev1.Secret {
+				secret := testTLSSecret(t, webhookSecret, webhookDNSName, expiresAt(time.Hour))
+				delete(secret.Data, caCertificateKey)
+				return []*corev1.Secret{secret}
+			},
+			wantErr:   `failed to read webhook certificate from external-secrets/webhook-tls secret: "ca.crt" key does not exist`,
+			wantCause: common.InvalidConfiguration,
+		},
+		{
+			name:    "webhook certificate secret does not exist",
+			esc:     webhookCert,
+			wantErr: "failed to fetch external-secrets/webhook-tls webhook certificate secret: secret does not exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			secrets := map[string]*corev1.Secret{}
+			if tt.secrets != nil {
+				for _, secret := range tt.secrets(t) {
+					secrets[secret.GetName()] = secret
+				}
+			}
+			mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+				secret, ok := secrets[ns.Name]
+				if !ok || ns.Namespace != commontest.TestExternalSecretsNamespace {
+					return false, nil
+				}
+				secret.DeepCopyInto(obj.(*corev1.Secret))
+				return true, nil
+			})
+			r.CtrlClient = mock
+			r.UncachedClient = mock
+			esc := commontest.TestExternalSecretsConfig()
+			if tt.esc != nil {
+				tt.esc(esc)
+			}
+
+			cond, err := r.getCertificateExpiringCondition(esc)
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("getCertificateExpiringCondition() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if cause := common.GetErrorCause(err); cause != tt.wantCause {
+				t.Errorf("getCertificateExpiringCondition() cause: %q, wantCause: %q", cause, tt.wantCause)
+			}
+			if (cond == nil) != (tt.wantCond == nil) {
+				t.Fatalf("getCertificateExpiringCondition() condition: %+v, want: %+v", cond, tt.wantCond)
+			}
+			if cond == nil {
+				return
+			}
+			if cond.Type != v1alpha1.CertificateExpiring || cond.Status != tt.wantCond.Status || cond.Reason != tt.wantCond.Reason {
+				t.Errorf("getCertificateExpiringCondition() condition: %+v, want: %+v", cond, tt.wantCond)
+			}
+			if tt.wantMessage != nil && cond.Message != tt.wantMessage() {
+				t.Errorf("getCertificateExpiringCondition() message: %q, want: %q", cond.Message, tt.wantMessage())
+			}
+		})
+	}
+}
+
+func TestSetCertificateExpiringCondition(t *testing.T) {
+	expiring := &metav1.Condition{
+		Type:    v1alpha1.CertificateExpiring,
+		Status:  metav1.ConditionTrue,
+		Reason:  v1alpha1.ReasonExpiring,
+		Message: "webhook certificate in external-secrets/webhook-tls secret expires at 2026-01-01T00:00:00Z",
+	}
+	r := testReconciler(t)
+	esc := commontest.TestExternalSecretsConfig()
+
+	r.setCertificateExpiringCondition(esc, expiring)
+	assertEvent(t, r, "Warning CertificateExpiring "+expiring.Message)
+
+	// event is not repeated for the same certificates.
+	r.setCertificateExpiringCondition(esc, expiring)
+	assertEvent(t, r, "")
+
+	r.setCertificateExpiringCondition(esc, nil)
+	if len(esc.Status.Conditions) != 0 {
+		t.Errorf("setCertificateExpiringCondition() conditions: %+v, want none", esc.Status.Conditions)
+	}
+}
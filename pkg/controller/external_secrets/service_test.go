@@ -2,6 +2,7 @@ package external_secrets
 
 import (
 	"context"
+	"maps"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -10,6 +11,7 @@ import (
 
 	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
 	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
 )
 
@@ -46,7 +48,7 @@ func TestCreateOrApplyServices(t *testing.T) {
 					}
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch svc := obj.(type) {
 					case *corev1.Service:
 						if svc.Name == "bitwarden-sdk-server" {
@@ -65,7 +67,7 @@ func TestCreateOrApplyServices(t *testing.T) {
 					},
 				}
 			},
-			wantErr: `failed to create service external-secrets/bitwarden-sdk-server: test client error`,
+			wantErr: `failed to apply external-secrets/bitwarden-sdk-server service resource: test client error`,
 		},
 
 		{
@@ -75,7 +77,7 @@ func TestCreateOrApplyServices(t *testing.T) {
 					return false, commontest.TestClientError
 				})
 			},
-			wantErr: `failed to check existence of service external-secrets/external-secrets-webhook: test client error`,
+			wantErr: `failed to check external-secrets/external-secrets-webhook service resource already exists: test client error`,
 		},
 		{
 			name: "service reconciliation fails while updating to desired state",
@@ -90,11 +92,11 @@ func TestCreateOrApplyServices(t *testing.T) {
 					}
 					return false, nil
 				})
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					return commontest.TestClientError
 				})
 			},
-			wantErr: `failed to update service external-secrets/external-secrets-webhook: test client error`,
+			wantErr: `failed to apply external-secrets/external-secrets-webhook service resource: test client error`,
 		},
 		{
 			name: "service reconciliation fails while creating",
@@ -102,7 +104,7 @@ func TestCreateOrApplyServices(t *testing.T) {
 				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch svc := obj.(type) {
 					case *corev1.Service:
 						if svc.Name != "external-secrets-webhook" {
@@ -112,7 +114,7 @@ func TestCreateOrApplyServices(t *testing.T) {
 					return commontest.TestClientError
 				})
 			},
-			wantErr: `failed to create service external-secrets/external-secrets-webhook: test client error`,
+			wantErr: `failed to apply external-secrets/external-secrets-webhook service resource: test client error`,
 		},
 	}
 
@@ -135,3 +137,76 @@ func TestCreateOrApplyServices(t *testing.T) {
 		})
 	}
 }
+
+func TestServingCertSecretAnnotation(t *testing.T) {
+	serviceCA := &operatorv1alpha1.CertProvidersConfig{
+		ServiceCA: &operatorv1alpha1.ServiceCAConfig{
+			Mode: operatorv1alpha1.Enabled,
+		},
+	}
+	tests := []struct {
+		name                        string
+		updateExternalSecretsConfig func(esc *operatorv1alpha1.ExternalSecretsConfig)
+		want                        map[string]string
+	}{
+		{
+			name: "service-ca not enabled",
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.Plugins.BitwardenSecretManagerProvider = &operatorv1alpha1.BitwardenSecretManagerProvider{
+					Mode: operatorv1alpha1.Enabled,
+				}
+			},
+			want: map[string]string{},
+		},
+		{
+			name: "service-ca enabled",
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = serviceCA
+				esc.Spec.Plugins.BitwardenSecretManagerProvider = &operatorv1alpha1.BitwardenSecretManagerProvider{
+					Mode: operatorv1alpha1.Enabled,
+				}
+			},
+			want: map[string]string{
+				"external-secrets-webhook": serviceCATLSSecretWebhook,
+				"bitwarden-sdk-server":     serviceCATLSSecretBitwarden,
+			},
+		},
+		{
+			name: "service-ca enabled with bitwarden secretRef",
+			updateExternalSecretsConfig: func(esc *operatorv1alpha1.ExternalSecretsConfig) {
+				esc.Spec.ControllerConfig.CertProvider = serviceCA
+				esc.Spec.Plugins.BitwardenSecretManagerProvider = &operatorv1alpha1.BitwardenSecretManagerProvider{
+					Mode:      operatorv1alpha1.Enabled,
+					SecretRef: &operatorv1alpha1.SecretReference{Name: "bitwarden-user-certs"},
+				}
+			},
+			want: map[string]string{
+				"external-secrets-webhook": serviceCATLSSecretWebhook,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			got := make(map[string]string)
+			mock.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
+				if name, ok := obj.GetAnnotations()[common.ServiceCAServingCertSecretNameAnnotation]; ok {
+					got[obj.GetName()] = name
+				}
+				return nil
+			})
+			r.CtrlClient = mock
+			esc := commontest.TestExternalSecretsConfig()
+			tt.updateExternalSecretsConfig(esc)
+
+			if err := r.createOrApplyServices(esc, controllerDefaultResourceLabels, false); err != nil {
+				t.Fatalf("createOrApplyServices() unexpected error: %v", err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("createOrApplyServices() serving-cert-secret-name annotations: %v, want: %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,10 +1,7 @@
 package external_secrets
 
 import (
-	"fmt"
-
 	corev1 "k8s.io/api/core/v1"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/common"
@@ -27,7 +24,7 @@ func (r *Reconciler) createOrApplyServiceAccounts(esc *operatorv1alpha1.External
 		},
 		{
 			assetName: certControllerServiceAccountAssetName,
-			condition: !isCertManagerConfigEnabled(esc),
+			condition: isCertControllerEnabled(esc),
 		},
 		{
 			assetName: bitwardenServiceAccountAssetName,
@@ -43,26 +40,8 @@ func (r *Reconciler) createOrApplyServiceAccounts(esc *operatorv1alpha1.External
 		desired := common.DecodeServiceAccountObjBytes(assets.MustAsset(serviceAccount.assetName))
 		updateNamespace(desired, esc)
 		common.UpdateResourceLabels(desired, resourceLabels)
-
-		serviceAccountName := fmt.Sprintf("%s/%s", desired.GetNamespace(), desired.GetName())
-		r.log.V(4).Info("reconciling serviceaccount resource", "name", serviceAccountName)
-
-		fetched := &corev1.ServiceAccount{}
-		exist, err := r.Exists(r.ctx, client.ObjectKeyFromObject(desired), fetched)
-		if err != nil {
-			return common.FromClientError(err, "failed to check if serviceaccount %s exists", serviceAccountName)
-		}
-
-		if exist {
-			if externalSecretsConfigCreateRecon {
-				r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "%s serviceaccount already exists, possibly from a previous install", serviceAccountName)
-			}
-			r.log.V(4).Info("serviceaccount exists", "name", serviceAccountName)
-		} else {
-			if err := r.Create(r.ctx, desired); err != nil {
-				return common.FromClientError(err, "failed to create serviceaccount %s", serviceAccountName)
-			}
-			r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "Created serviceaccount %s", serviceAccountName)
+		if err := r.applyResource(esc, desired, &corev1.ServiceAccount{}, "serviceaccount", externalSecretsConfigCreateRecon); err != nil {
+			return err
 		}
 	}
 
@@ -44,7 +44,7 @@ func TestCreateOrApplyServiceAccounts(t *testing.T) {
 					expectedSAMap[name] = testServiceAccount(path)
 				}
 
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if sa, ok := obj.(*corev1.ServiceAccount); ok {
 						if _, found := expectedSAMap[sa.Name]; found {
 
@@ -62,7 +62,7 @@ func TestCreateOrApplyServiceAccounts(t *testing.T) {
 				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					expectedSA := testServiceAccount("external-secrets/resources/serviceaccount_bitwarden-sdk-server.yml")
 					if sa, ok := obj.(*corev1.ServiceAccount); ok {
 						if sa.Name == expectedSA.Name {
@@ -85,7 +85,7 @@ func TestCreateOrApplyServiceAccounts(t *testing.T) {
 		{
 			name: "cert-controller serviceaccount skipped when cert-manager enabled",
 			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if sa, ok := obj.(*corev1.ServiceAccount); ok {
 						if sa.Name == "external-secrets-cert-controller" {
 							return testErr // should not be called
@@ -113,14 +113,14 @@ func TestCreateOrApplyServiceAccounts(t *testing.T) {
 				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					if sa, ok := obj.(*corev1.ServiceAccount); ok && sa.Name == "external-secrets" {
 						return testErr
 					}
 					return nil
 				})
 			},
-			wantErr: "failed to create serviceaccount external-secrets/external-secrets: test client error",
+			wantErr: "failed to apply external-secrets/external-secrets serviceaccount resource: test client error",
 		},
 	}
 
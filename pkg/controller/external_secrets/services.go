@@ -1,10 +1,7 @@
 package external_secrets
 
 import (
-	"fmt"
-
 	corev1 "k8s.io/api/core/v1"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/common"
@@ -27,7 +24,7 @@ func (r *Reconciler) createOrApplyServices(esc *operatorv1alpha1.ExternalSecrets
 		},
 		{
 			assetName: certControllerMetricsServiceAssetName,
-			condition: !isCertManagerConfigEnabled(esc),
+			condition: isCertControllerEnabled(esc),
 		},
 		{
 			assetName: bitwardenServiceAssetName,
@@ -52,35 +49,35 @@ func (r *Reconciler) createOrApplyServiceFromAsset(esc *operatorv1alpha1.Externa
 	service := common.DecodeServiceObjBytes(assets.MustAsset(assetName))
 	updateNamespace(service, esc)
 	common.UpdateResourceLabels(service, resourceLabels)
+	updateServingCertSecretAnnotation(service, esc, assetName)
+	return r.applyResource(esc, service, &corev1.Service{}, "service", externalSecretsConfigCreateRecon)
+}
 
-	serviceName := fmt.Sprintf("%s/%s", service.GetNamespace(), service.GetName())
-	r.log.V(4).Info("Reconciling service", "name", serviceName)
-
-	fetched := &corev1.Service{}
-	exists, err := r.Exists(r.ctx, client.ObjectKeyFromObject(service), fetched)
-	if err != nil {
-		return common.FromClientError(err, "failed to check existence of service %s", serviceName)
+// updateServingCertSecretAnnotation is for annotating the webhook and bitwarden-sdk-server Services, for the
+// OpenShift service-ca operator to generate the serving certificates, when service-ca config is enabled.
+func updateServingCertSecretAnnotation(service *corev1.Service, esc *operatorv1alpha1.ExternalSecretsConfig, assetName string) {
+	if !common.IsServiceCAConfigEnabled(esc) {
+		return
 	}
 
-	if exists {
-		if externalSecretsConfigCreateRecon {
-			r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "%s already exists", serviceName)
-		}
-		if common.HasObjectChanged(service, fetched) {
-			r.log.V(1).Info("Service modified, updating", "name", serviceName)
-			if err := r.UpdateWithRetry(r.ctx, service); err != nil {
-				return common.FromClientError(err, "failed to update service %s", serviceName)
-			}
-			r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "Service %s updated", serviceName)
-		} else {
-			r.log.V(4).Info("Service already up-to-date", "name", serviceName)
+	var secretName string
+	switch assetName {
+	case webhookServiceAssetName:
+		secretName = serviceCATLSSecretWebhook
+	case bitwardenServiceAssetName:
+		// user provided certificate takes precedence over the service-ca generated one.
+		if isBitwardenSecretRefConfigured(esc) {
+			return
 		}
-	} else {
-		if err := r.Create(r.ctx, service); err != nil {
-			return common.FromClientError(err, "failed to create service %s", serviceName)
-		}
-		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "Service %s created", serviceName)
+		secretName = serviceCATLSSecretBitwarden
+	default:
+		return
 	}
 
-	return nil
+	annotations := service.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[common.ServiceCAServingCertSecretNameAnnotation] = secretName
+	service.SetAnnotations(annotations)
 }
@@ -127,3 +127,17 @@ func testNetworkPolicy(assetName string) *networkingv1.NetworkPolicy {
 	networkPolicy.SetLabels(controllerDefaultResourceLabels)
 	return networkPolicy
 }
+
+// assertEvent checks the last event recorded is the wanted event, or that no event
+// was recorded when the wanted event is empty.
+func assertEvent(t *testing.T, r *Reconciler, want string) {
+	t.Helper()
+	events := r.eventRecorder.(*record.FakeRecorder).Events
+	var got string
+	for len(events) > 0 {
+		got = <-events
+	}
+	if got != want {
+		t.Errorf("event: %q, want: %q", got, want)
+	}
+}
@@ -0,0 +1,148 @@
+package external_secrets
+
+import (
+	"fmt"
+	"strings"
+
+	webhook "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	certmanagerv1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
+)
+
+// managedResourceSelector identifies the resources created by the operator for the `external-secrets`
+// operand. `app=external-secrets` alone is not sufficient, since users could be creating resources with
+// the same label, like the ConfigMap with the CA certificates to be trusted by the operand.
+var managedResourceSelector = client.MatchingLabels{
+	requestEnqueueLabelKey:         requestEnqueueLabelValue,
+	"app.kubernetes.io/managed-by": common.ExternalSecretsOperatorCommonName,
+}
+
+// managedResourceKind is a kind of the resources created for the `external-secrets` operand.
+type managedResourceKind struct {
+	kind    string
+	newList func() client.ObjectList
+	// blocking indicates the deletion of the subsequent kinds must wait
+	// till all the resources of this kind are removed.
+	blocking bool
+}
+
+// getManagedResourceKinds returns the kinds of the resources created for the `external-secrets`
+// operand, in the order they must be deleted.
+func (r *Reconciler) getManagedResourceKinds() []managedResourceKind {
+	kinds := []managedResourceKind{
+		{kind: "validatingwebhookconfiguration", newList: func() client.ObjectList { return &webhook.ValidatingWebhookConfigurationList{} }, blocking: true},
+		{kind: "deployment", newList: func() client.ObjectList { return &appsv1.DeploymentList{} }},
+		{kind: "poddisruptionbudget", newList: func() client.ObjectList { return &policyv1.PodDisruptionBudgetList{} }},
+	}
+	if r.IsCertManagerInstalled() {
+		kinds = append(kinds, managedResourceKind{kind: "certificate", newList: func() client.ObjectList { return &certmanagerv1.CertificateList{} }})
+	}
+	if r.isMonitoringInstalled() {
+		kinds = append(kinds,
+			managedResourceKind{kind: "prometheusrule", newList: func() client.ObjectList { return newUnstructuredList(prometheusRuleGVK) }},
+			managedResourceKind{kind: "servicemonitor", newList: func() client.ObjectList { return newUnstructuredList(serviceMonitorGVK) }},
+		)
+	}
+	return append(kinds,
+		managedResourceKind{kind: "service", newList: func() client.ObjectList { return &corev1.ServiceList{} }},
+		managedResourceKind{kind: "networkpolicy", newList: func() client.ObjectList { return &networkingv1.NetworkPolicyList{} }},
+		managedResourceKind{kind: "rolebinding", newList: func() client.ObjectList { return &rbacv1.RoleBindingList{} }},
+		managedResourceKind{kind: "role", newList: func() client.ObjectList { return &rbacv1.RoleList{} }},
+		managedResourceKind{kind: "clusterrolebinding", newList: func() client.ObjectList { return &rbacv1.ClusterRoleBindingList{} }},
+		managedResourceKind{kind: "clusterrole", newList: func() client.ObjectList { return &rbacv1.ClusterRoleList{} }},
+		managedResourceKind{kind: "serviceaccount", newList: func() client.ObjectList { return &corev1.ServiceAccountList{} }},
+		managedResourceKind{kind: "secret", newList: func() client.ObjectList { return &corev1.SecretList{} }},
+		managedResourceKind{kind: "configmap", newList: func() client.ObjectList { return &corev1.ConfigMapList{} }},
+	)
+}
+
+// deleteExternalSecretsDeployment deletes all the resources created for the `external-secrets` operand, identified
+// by the managedResourceSelector labels. The validating webhook configurations are deleted first and the remaining
+// resources are deleted only once the webhooks are gone. Returns whether the deletion is still in progress.
+func (r *Reconciler) deleteExternalSecretsDeployment(esc *operatorv1alpha1.ExternalSecretsConfig) (bool, error) {
+	remaining := make([]string, 0)
+	for _, k := range r.getManagedResourceKinds() {
+		count, err := r.deleteManagedResources(esc, k, nil)
+		if err != nil {
+			return true, err
+		}
+		if count != 0 {
+			remaining = append(remaining, fmt.Sprintf("%d %s", count, k.kind))
+		}
+		if k.blocking && count != 0 {
+			break
+		}
+	}
+
+	if len(remaining) == 0 {
+		r.log.V(1).Info("all resources created for external-secrets deployment are deleted")
+		return false, nil
+	}
+
+	readyCond := metav1.Condition{
+		Type:               operatorv1alpha1.Ready,
+		Status:             metav1.ConditionFalse,
+		Reason:             operatorv1alpha1.ReasonDeleting,
+		Message:            fmt.Sprintf("waiting for resources to be deleted: %s", strings.Join(remaining, ", ")),
+		ObservedGeneration: esc.GetGeneration(),
+	}
+	if apimeta.SetStatusCondition(&esc.Status.Conditions, readyCond) {
+		if err := r.updateCondition(esc, nil); err != nil {
+			return true, err
+		}
+	}
+
+	return true, nil
+}
+
+// deleteManagedResources deletes the resources of the given kind created for the `external-secrets` operand,
+// except for those in the retain inventory, and returns the count of the resources yet to be removed.
+func (r *Reconciler) deleteManagedResources(esc *operatorv1alpha1.ExternalSecretsConfig, k managedResourceKind, retain resourceInventory) (int, error) {
+	list := k.newList()
+	if err := r.List(r.ctx, list, managedResourceSelector); err != nil {
+		return 0, common.FromClientError(err, "failed to list %s resources for deletion", k.kind)
+	}
+
+	objs, err := apimeta.ExtractList(list)
+	if err != nil {
+		return 0, fmt.Errorf("failed to extract %s resources from list: %w", k.kind, err)
+	}
+
+	count := 0
+	for _, o := range objs {
+		obj, ok := o.(client.Object)
+		if !ok || retain.has(obj) {
+			continue
+		}
+		count++
+		if !obj.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		name := obj.GetName()
+		if obj.GetNamespace() != "" {
+			name = fmt.Sprintf("%s/%s", obj.GetNamespace(), name)
+		}
+		if err := r.Delete(r.ctx, obj); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return 0, common.FromClientError(err, "failed to delete %s %s resource", name, k.kind)
+		}
+		r.log.V(1).Info("deleted resource created for external-secrets deployment", "kind", k.kind, "name", name)
+		r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Deleted", "%s resource %s deleted", k.kind, name)
+	}
+
+	return count, nil
+}
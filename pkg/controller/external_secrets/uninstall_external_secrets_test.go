@@ -0,0 +1,197 @@
+package external_secrets
+
+import (
+	"context"
+	"testing"
+
+	webhook "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift/external-secrets-operator/api/v1alpha1"
+	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
+)
+
+func TestCleanUp(t *testing.T) {
+	tests := []struct {
+		name              string
+		deletionPolicy    v1alpha1.DeletionPolicy
+		managementState   v1alpha1.ManagementState
+		preReq            func(*Reconciler, *fakes.FakeCtrlClient)
+		wantRequeue       bool
+		wantDeleted       int
+		wantListed        int
+		wantFinalizerGone bool
+		wantReadyReason   string
+		wantErr           string
+	}{
+		{
+			name:              "deletion policy not set, resources are orphaned",
+			wantFinalizerGone: true,
+		},
+		{
+			name:              "deletion policy orphan, resources are orphaned",
+			deletionPolicy:    v1alpha1.DeletionPolicyOrphan,
+			wantFinalizerGone: true,
+		},
+		{
+			name:              "management state unmanaged, resources are orphaned",
+			deletionPolicy:    v1alpha1.DeletionPolicyDelete,
+			managementState:   v1alpha1.Unmanaged,
+			wantFinalizerGone: true,
+		},
+		{
+			name:           "webhooks are deleted before other resources",
+			deletionPolicy: v1alpha1.DeletionPolicyDelete,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ListCalls(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					switch l := list.(type) {
+					case *webhook.ValidatingWebhookConfigurationList:
+						l.Items = []webhook.ValidatingWebhookConfiguration{
+							*testValidatingWebhookConfiguration(validatingWebhookExternalSecretCRDAssetName),
+							*testValidatingWebhookConfiguration(validatingWebhookSecretStoreCRDAssetName),
+						}
+					case *appsv1.DeploymentList:
+						l.Items = []appsv1.Deployment{*testDeployment(controllerDeploymentAssetName)}
+					}
+					return nil
+				})
+			},
+			wantRequeue:     true,
+			wantDeleted:     2,
+			wantListed:      1,
+			wantReadyReason: v1alpha1.ReasonDeleting,
+		},
+		{
+			name:           "remaining resources deleted once webhooks are removed",
+			deletionPolicy: v1alpha1.DeletionPolicyDelete,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ListCalls(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					switch l := list.(type) {
+					case *appsv1.DeploymentList:
+						deleting := testDeployment(webhookDeploymentAssetName)
+						deleting.SetDeletionTimestamp(ptr.To(metav1.Now()))
+						l.Items = []appsv1.Deployment{*testDeployment(controllerDeploymentAssetName), *deleting}
+					case *rbacv1.ClusterRoleList:
+						l.Items = []rbacv1.ClusterRole{*testClusterRole(controllerClusterRoleAssetName)}
+					}
+					return nil
+				})
+			},
+			wantRequeue:     true,
+			wantDeleted:     2,
+			wantListed:      12,
+			wantReadyReason: v1alpha1.ReasonDeleting,
+		},
+		{
+			name:           "resource already removed",
+			deletionPolicy: v1alpha1.DeletionPolicyDelete,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ListCalls(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					if l, ok := list.(*rbacv1.ClusterRoleList); ok {
+						l.Items = []rbacv1.ClusterRole{*testClusterRole(controllerClusterRoleAssetName)}
+					}
+					return nil
+				})
+				m.DeleteReturns(apierrors.NewNotFound(schema.GroupResource{}, "external-secrets-controller"))
+			},
+			wantRequeue:     true,
+			wantDeleted:     1,
+			wantListed:      12,
+			wantReadyReason: v1alpha1.ReasonDeleting,
+		},
+		{
+			name:              "all resources deleted, finalizer removed",
+			deletionPolicy:    v1alpha1.DeletionPolicyDelete,
+			wantListed:        12,
+			wantFinalizerGone: true,
+		},
+		{
+			name:           "listing resources fails",
+			deletionPolicy: v1alpha1.DeletionPolicyDelete,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ListReturns(commontest.TestClientError)
+			},
+			wantRequeue: true,
+			wantListed:  1,
+			wantErr:     "failed to list validatingwebhookconfiguration resources for deletion: test client error",
+		},
+		{
+			name:           "deleting resource fails",
+			deletionPolicy: v1alpha1.DeletionPolicyDelete,
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.ListCalls(func(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+					if l, ok := list.(*webhook.ValidatingWebhookConfigurationList); ok {
+						l.Items = []webhook.ValidatingWebhookConfiguration{
+							*testValidatingWebhookConfiguration(validatingWebhookExternalSecretCRDAssetName),
+						}
+					}
+					return nil
+				})
+				m.DeleteReturns(commontest.TestClientError)
+			},
+			wantRequeue: true,
+			wantDeleted: 1,
+			wantListed:  1,
+			wantErr:     "failed to delete externalsecret-validate validatingwebhookconfiguration resource: test client error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := testReconciler(t)
+			mock := &fakes.FakeCtrlClient{}
+			r.CtrlClient = mock
+			if tt.preReq != nil {
+				tt.preReq(r, mock)
+			}
+			esc := commontest.TestExternalSecretsConfig()
+			esc.Spec.DeletionPolicy = tt.deletionPolicy
+			esc.Spec.ManagementState = tt.managementState
+			esc.SetFinalizers([]string{finalizer})
+
+			requeue, err := r.cleanUp(esc, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(esc)})
+			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("cleanUp() err: %v, wantErr: %v", err, tt.wantErr)
+			}
+			if requeue != tt.wantRequeue {
+				t.Errorf("cleanUp() requeue: %v, want: %v", requeue, tt.wantRequeue)
+			}
+			if got := mock.DeleteCallCount(); got != tt.wantDeleted {
+				t.Errorf("cleanUp() deleted resources: %d, want: %d", got, tt.wantDeleted)
+			}
+			if got := mock.ListCallCount(); got != tt.wantListed {
+				t.Errorf("cleanUp() listed resource kinds: %d, want: %d", got, tt.wantListed)
+			}
+			for i := 0; i < mock.ListCallCount(); i++ {
+				_, _, opts := mock.ListArgsForCall(i)
+				listOpts := &client.ListOptions{}
+				for _, o := range opts {
+					o.ApplyToList(listOpts)
+				}
+				want := labels.SelectorFromSet(labels.Set(managedResourceSelector))
+				if listOpts.LabelSelector == nil || listOpts.LabelSelector.String() != want.String() {
+					t.Errorf("cleanUp() list selector: %v, want: %s", listOpts.LabelSelector, want)
+				}
+			}
+			if got := len(esc.GetFinalizers()) == 0; got != tt.wantFinalizerGone {
+				t.Errorf("cleanUp() finalizer removed: %v, want: %v", got, tt.wantFinalizerGone)
+			}
+			if tt.wantReadyReason != "" {
+				cond := apimeta.FindStatusCondition(esc.Status.Conditions, v1alpha1.Ready)
+				if cond == nil || cond.Reason != tt.wantReadyReason {
+					t.Errorf("cleanUp() ready condition: %v, want reason: %s", cond, tt.wantReadyReason)
+				}
+			}
+		})
+	}
+}
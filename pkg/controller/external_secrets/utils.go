@@ -3,9 +3,12 @@ package external_secrets
 import (
 	"context"
 	"fmt"
+	"time"
 
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"go.uber.org/zap/zapcore"
@@ -14,8 +17,8 @@ import (
 	"github.com/openshift/external-secrets-operator/pkg/controller/common"
 )
 
-func getNamespace(_ *operatorv1alpha1.ExternalSecretsConfig) string {
-	return externalsecretsDefaultNamespace
+func getNamespace(esc *operatorv1alpha1.ExternalSecretsConfig) string {
+	return common.GetExternalSecretsNamespace(esc)
 }
 
 func updateNamespace(obj client.Object, esc *operatorv1alpha1.ExternalSecretsConfig) {
@@ -92,12 +95,69 @@ func isCertManagerConfigEnabled(esc *operatorv1alpha1.ExternalSecretsConfig) boo
 		common.EvalMode(esc.Spec.ControllerConfig.CertProvider.CertManager.Mode)
 }
 
+// isCertControllerEnabled returns whether the in-built cert-controller is to be deployed for obtaining the
+// webhook certificates, which is when neither cert-manager nor service-ca is enabled, and the webhook
+// certificate is not provided by the user in ExternalSecretsConfig CR Spec.
+func isCertControllerEnabled(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	return !isCertManagerConfigEnabled(esc) && !common.IsServiceCAConfigEnabled(esc) && getWebhookCertificateSecretRef(esc) == nil
+}
+
+// getWebhookCertificateSecretRef returns the reference to the user provided webhook certificate secret, if configured.
+func getWebhookCertificateSecretRef(esc *operatorv1alpha1.ExternalSecretsConfig) *operatorv1alpha1.SecretReference {
+	if esc.Spec.ApplicationConfig.WebhookConfig == nil ||
+		esc.Spec.ApplicationConfig.WebhookConfig.CertificateSecretRef == nil ||
+		esc.Spec.ApplicationConfig.WebhookConfig.CertificateSecretRef.Name == "" {
+		return nil
+	}
+	return esc.Spec.ApplicationConfig.WebhookConfig.CertificateSecretRef
+}
+
+// getCertificateExpiryWarningWindow returns the period before the expiry of the user provided certificates,
+// from when the certificates are reported as expiring.
+func getCertificateExpiryWarningWindow(esc *operatorv1alpha1.ExternalSecretsConfig) time.Duration {
+	if esc.Spec.ApplicationConfig.CertificateExpiryWarningWindow != nil {
+		return esc.Spec.ApplicationConfig.CertificateExpiryWarningWindow.Duration
+	}
+	return defaultCertificateExpiryWarningWindow
+}
+
 // isBitwardenConfigEnabled returns whether BitwardenSecretManagerProvider is enabled in ExternalSecretsConfig CR Spec.
 func isBitwardenConfigEnabled(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
 	return esc.Spec.Plugins.BitwardenSecretManagerProvider != nil &&
 		common.EvalMode(esc.Spec.Plugins.BitwardenSecretManagerProvider.Mode)
 }
 
+// isBitwardenSecretRefConfigured returns whether the user provided TLS secret is configured for the bitwarden-sdk-server.
+func isBitwardenSecretRefConfigured(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	return esc.Spec.Plugins.BitwardenSecretManagerProvider != nil &&
+		esc.Spec.Plugins.BitwardenSecretManagerProvider.SecretRef != nil &&
+		esc.Spec.Plugins.BitwardenSecretManagerProvider.SecretRef.Name != ""
+}
+
+// isClusterTrustedCABundleEnabled returns whether the OpenShift cluster-wide trusted CA bundle is enabled in ExternalSecretsConfig CR Spec.
+func isClusterTrustedCABundleEnabled(esc *operatorv1alpha1.ExternalSecretsConfig) bool {
+	return esc.Spec.ApplicationConfig.TrustedCABundle != nil &&
+		common.EvalMode(esc.Spec.ApplicationConfig.TrustedCABundle.ClusterTrustedCABundle)
+}
+
+// getTrustedCABundleConfigMapRef returns the reference to the user provided trusted CA bundle ConfigMap, if configured.
+func getTrustedCABundleConfigMapRef(esc *operatorv1alpha1.ExternalSecretsConfig) *operatorv1alpha1.ConfigMapKeyReference {
+	if esc.Spec.ApplicationConfig.TrustedCABundle == nil ||
+		esc.Spec.ApplicationConfig.TrustedCABundle.ConfigMapRef == nil ||
+		esc.Spec.ApplicationConfig.TrustedCABundle.ConfigMapRef.Name == "" {
+		return nil
+	}
+	return esc.Spec.ApplicationConfig.TrustedCABundle.ConfigMapRef
+}
+
+// getTrustedCABundleKey returns the key holding the CA certificates in the referenced ConfigMap.
+func getTrustedCABundleKey(ref *operatorv1alpha1.ConfigMapKeyReference) string {
+	if ref.Key != "" {
+		return ref.Key
+	}
+	return trustedCABundleKey
+}
+
 func getLogLevel(esc *operatorv1alpha1.ExternalSecretsConfig, esm *operatorv1alpha1.ExternalSecretsManager) string {
 	var logLevel int32 = 1
 	if esc.Spec.ApplicationConfig.LogLevel != 0 {
@@ -118,6 +178,41 @@ func getOperatingNamespace(esc *operatorv1alpha1.ExternalSecretsConfig) string {
 	return esc.Spec.ApplicationConfig.OperatingNamespace
 }
 
+// getComponentReplicas returns the number of pods configured for the component deployment, which is 1
+// when not configured, and for the components not supporting more than one replica.
+func getComponentReplicas(esc *operatorv1alpha1.ExternalSecretsConfig, deploymentAssetName string) int32 {
+	replicas := esc.Spec.ApplicationConfig.Replicas
+	if replicas == nil {
+		return 1
+	}
+	switch deploymentAssetName {
+	case controllerDeploymentAssetName:
+		return ptr.Deref(replicas.Controller, 1)
+	case webhookDeploymentAssetName:
+		return ptr.Deref(replicas.Webhook, 1)
+	}
+	return 1
+}
+
+// deploymentComponentNames is the name of the component, for which each of the operand deployments is created.
+var deploymentComponentNames = map[string]operatorv1alpha1.ComponentName{
+	controllerDeploymentAssetName:     operatorv1alpha1.CoreController,
+	webhookDeploymentAssetName:        operatorv1alpha1.Webhook,
+	certControllerDeploymentAssetName: operatorv1alpha1.CertController,
+	bitwardenDeploymentAssetName:      operatorv1alpha1.BitwardenSDKServer,
+}
+
+// getComponentConfig returns the configurations overridden for the component of the deployment, along with
+// the field path of it for reporting the validation errors, and is nil when not configured.
+func getComponentConfig(esc *operatorv1alpha1.ExternalSecretsConfig, deploymentAssetName string) (*operatorv1alpha1.ComponentConfig, *field.Path) {
+	name := deploymentComponentNames[deploymentAssetName]
+	config, ok := esc.Spec.ApplicationConfig.Components[name]
+	if !ok {
+		return nil, nil
+	}
+	return &config, field.NewPath("spec", "appConfig", "components").Key(string(name))
+}
+
 func (r *Reconciler) IsCertManagerInstalled() bool {
 	_, ok := r.optionalResourcesList[certificateCRDGKV]
 	return ok
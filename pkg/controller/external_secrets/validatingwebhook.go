@@ -4,8 +4,6 @@ import (
 	"fmt"
 
 	webhook "k8s.io/api/admissionregistration/v1"
-	corev1 "k8s.io/api/core/v1"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/common"
@@ -19,32 +17,8 @@ func (r *Reconciler) createOrApplyValidatingWebhookConfiguration(esc *operatorv1
 	}
 
 	for _, desired := range desiredWebhooks {
-		validatingWebhookName := desired.GetName()
-		r.log.V(4).Info("reconciling validatingWebhook resource", "name", validatingWebhookName)
-		fetched := &webhook.ValidatingWebhookConfiguration{}
-		exist, err := r.Exists(r.ctx, client.ObjectKeyFromObject(desired), fetched)
-		if err != nil {
-			return common.FromClientError(err, "failed to check %s validatingWebhook resource already exists", validatingWebhookName)
-		}
-
-		if exist && recon {
-			r.eventRecorder.Eventf(esc, corev1.EventTypeWarning, "ResourceAlreadyExists", "%s validatingWebhook resource already exists, maybe from previous installation", validatingWebhookName)
-		}
-		if exist && common.HasObjectChanged(desired, fetched) {
-			r.log.V(1).Info("validatingWebhook has been modified", "updating to desired state", "name", validatingWebhookName)
-			if err := r.UpdateWithRetry(r.ctx, desired); err != nil {
-				return common.FromClientError(err, "failed to update %s validatingWebhook resource with desired state", validatingWebhookName)
-			}
-			r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "validatingWebhook resource %s reconciled back to desired state", validatingWebhookName)
-		} else {
-			r.log.V(4).Info("validatingWebhook resource already exists and is in expected state", "name", validatingWebhookName)
-		}
-
-		if !exist {
-			if err := r.Create(r.ctx, desired); err != nil {
-				return common.FromClientError(err, "failed to create validatingWebhook resource %s", validatingWebhookName)
-			}
-			r.eventRecorder.Eventf(esc, corev1.EventTypeNormal, "Reconciled", "validatingWebhook resource %s created", validatingWebhookName)
+		if err := r.applyResource(esc, desired, &webhook.ValidatingWebhookConfiguration{}, "validatingwebhookconfiguration", recon); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -54,11 +28,18 @@ func (r *Reconciler) createOrApplyValidatingWebhookConfiguration(esc *operatorv1
 func (r *Reconciler) getValidatingWebhookObjects(esc *operatorv1alpha1.ExternalSecretsConfig, resourceLabels map[string]string) ([]*webhook.ValidatingWebhookConfiguration, error) {
 	var webhooks []*webhook.ValidatingWebhookConfiguration
 
+	caBundle, err := r.getWebhookCABundle(esc)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, assetName := range []string{validatingWebhookExternalSecretCRDAssetName, validatingWebhookSecretStoreCRDAssetName} {
 
 		validatingWebhook := common.DecodeValidatingWebhookConfigurationObjBytes(assets.MustAsset(assetName))
 
 		common.UpdateResourceLabels(validatingWebhook, resourceLabels)
+		updateValidatingWebhookServiceNamespace(validatingWebhook, getNamespace(esc))
+		updateValidatingWebhookCABundle(validatingWebhook, caBundle)
 		if err := updateValidatingWebhookAnnotation(esc, validatingWebhook); err != nil {
 			return nil, fmt.Errorf("failed to update validatingWebhook resource for %s external secrets: %s", esc.GetName(), err.Error())
 		}
@@ -70,18 +51,49 @@ func (r *Reconciler) getValidatingWebhookObjects(esc *operatorv1alpha1.ExternalS
 }
 
 func updateValidatingWebhookAnnotation(esc *operatorv1alpha1.ExternalSecretsConfig, webhook *webhook.ValidatingWebhookConfiguration) error {
-	if common.IsInjectCertManagerAnnotationEnabled(esc) {
+	provider := getWebhookCertificateProvider(esc).Provider
+	if provider == operatorv1alpha1.CertManagerProvider && common.IsInjectCertManagerAnnotationEnabled(esc) {
 		if webhook.Annotations == nil {
 			webhook.Annotations = map[string]string{}
 		}
-		webhook.Annotations[common.CertManagerInjectCAFromAnnotation] = common.CertManagerInjectCAFromAnnotationValue
+		webhook.Annotations[common.CertManagerInjectCAFromAnnotation] = common.GetCertManagerInjectCAFromAnnotationValue(esc)
+		return nil
+	}
+	if provider == operatorv1alpha1.ServiceCAProvider {
+		if webhook.Annotations == nil {
+			webhook.Annotations = map[string]string{}
+		}
+		webhook.Annotations[common.ServiceCAInjectCABundleAnnotation] = "true"
 		return nil
 	}
 	if webhook.Annotations != nil {
 		delete(webhook.Annotations, common.CertManagerInjectCAFromAnnotation)
+		delete(webhook.Annotations, common.ServiceCAInjectCABundleAnnotation)
 		if len(webhook.Annotations) == 0 {
 			webhook.Annotations = nil
 		}
 	}
 	return nil
 }
+
+// updateValidatingWebhookCABundle is for setting the CA certificates of the user provided webhook certificate,
+// which the API server uses for verifying the webhook. The caBundle is otherwise injected by the certificate
+// provider, and must not be part of the desired state.
+func updateValidatingWebhookCABundle(webhook *webhook.ValidatingWebhookConfiguration, caBundle []byte) {
+	if len(caBundle) == 0 {
+		return
+	}
+	for i := range webhook.Webhooks {
+		webhook.Webhooks[i].ClientConfig.CABundle = caBundle
+	}
+}
+
+// updateValidatingWebhookServiceNamespace is for updating the namespace of the webhook service, which the
+// API server uses for reaching the webhook.
+func updateValidatingWebhookServiceNamespace(webhook *webhook.ValidatingWebhookConfiguration, namespace string) {
+	for i := range webhook.Webhooks {
+		if webhook.Webhooks[i].ClientConfig.Service != nil {
+			webhook.Webhooks[i].ClientConfig.Service.Namespace = namespace
+		}
+	}
+}
@@ -1,16 +1,20 @@
 package external_secrets
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	webhook "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/openshift/external-secrets-operator/api/v1alpha1"
 	"github.com/openshift/external-secrets-operator/pkg/controller/client/fakes"
+	"github.com/openshift/external-secrets-operator/pkg/controller/common"
 	"github.com/openshift/external-secrets-operator/pkg/controller/commontest"
 )
 
@@ -48,12 +52,12 @@ func TestCreateOrApplyValidatingWebhookConfiguration(t *testing.T) {
 					return false, nil
 				})
 			},
-			wantErr: fmt.Sprintf("failed to check %s validatingWebhook resource already exists: %s", testValidateWebhookConfigurationResourceName, commontest.TestClientError),
+			wantErr: fmt.Sprintf("failed to check %s validatingwebhookconfiguration resource already exists: %s", testValidateWebhookConfigurationResourceName, commontest.TestClientError),
 		},
 		{
 			name: "validatingWebhookConfiguration reconciliation fails while updating to desired state",
 			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
-				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, option ...client.UpdateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, option ...client.PatchOption) error {
 					switch obj.(type) {
 					case *webhook.ValidatingWebhookConfiguration:
 						return commontest.TestClientError
@@ -71,12 +75,12 @@ func TestCreateOrApplyValidatingWebhookConfiguration(t *testing.T) {
 					return false, nil
 				})
 			},
-			wantErr: fmt.Sprintf("failed to update %s validatingWebhook resource with desired state: %s", testValidateWebhookConfigurationResourceName, commontest.TestClientError),
+			wantErr: fmt.Sprintf("failed to apply %s validatingwebhookconfiguration resource: %s", testValidateWebhookConfigurationResourceName, commontest.TestClientError),
 		},
 		{
 			name: "validatingWebhookConfiguration reconciliation fails while creating",
 			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					switch obj.(type) {
 					case *webhook.ValidatingWebhookConfiguration:
 						return commontest.TestClientError
@@ -84,7 +88,7 @@ func TestCreateOrApplyValidatingWebhookConfiguration(t *testing.T) {
 					return nil
 				})
 			},
-			wantErr: fmt.Sprintf("failed to create validatingWebhook resource %s: %s", testValidateWebhookConfigurationResourceName, commontest.TestClientError),
+			wantErr: fmt.Sprintf("failed to apply %s validatingwebhookconfiguration resource: %s", testValidateWebhookConfigurationResourceName, commontest.TestClientError),
 		},
 		{
 			name: "validatingWebhookConfiguration creation successful",
@@ -92,7 +96,7 @@ func TestCreateOrApplyValidatingWebhookConfiguration(t *testing.T) {
 				m.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
 					return false, nil
 				})
-				m.CreateCalls(func(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+				m.ApplyCalls(func(ctx context.Context, obj client.Object, opts ...client.PatchOption) error {
 					return nil
 				})
 			},
@@ -129,3 +133,83 @@ func testExternalSecretsForValidateWebhookConfiguration() *v1alpha1.ExternalSecr
 	}
 	return esc
 }
+
+func TestGetValidatingWebhookObjectsWithCustomNamespace(t *testing.T) {
+	r := testReconciler(t)
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ApplicationConfig.Namespace = "test-eso"
+	esc.Spec.ControllerConfig.CertProvider = &v1alpha1.CertProvidersConfig{
+		CertManager: &v1alpha1.CertManagerConfig{
+			Mode:              v1alpha1.Enabled,
+			InjectAnnotations: "true",
+		},
+	}
+
+	webhooks, err := r.getValidatingWebhookObjects(esc, controllerDefaultResourceLabels)
+	if err != nil {
+		t.Fatalf("getValidatingWebhookObjects() err: %v", err)
+	}
+	for _, wh := range webhooks {
+		if got := wh.GetAnnotations()[common.CertManagerInjectCAFromAnnotation]; got != "test-eso/external-secrets-webhook" {
+			t.Errorf("getValidatingWebhookObjects() %s inject-ca-from annotation: %q", wh.GetName(), got)
+		}
+		for _, w := range wh.Webhooks {
+			if w.ClientConfig.Service == nil || w.ClientConfig.Service.Namespace != "test-eso" {
+				t.Errorf("getValidatingWebhookObjects() %s webhook service: %+v", w.Name, w.ClientConfig.Service)
+			}
+		}
+	}
+}
+
+func TestGetValidatingWebhookObjectsWithServiceCA(t *testing.T) {
+	r := testReconciler(t)
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ControllerConfig.CertProvider = &v1alpha1.CertProvidersConfig{
+		ServiceCA: &v1alpha1.ServiceCAConfig{
+			Mode: v1alpha1.Enabled,
+		},
+	}
+
+	webhooks, err := r.getValidatingWebhookObjects(esc, controllerDefaultResourceLabels)
+	if err != nil {
+		t.Fatalf("getValidatingWebhookObjects() err: %v", err)
+	}
+	for _, wh := range webhooks {
+		if got := wh.GetAnnotations()[common.ServiceCAInjectCABundleAnnotation]; got != "true" {
+			t.Errorf("getValidatingWebhookObjects() %s inject-cabundle annotation: %q", wh.GetName(), got)
+		}
+		if _, ok := wh.GetAnnotations()[common.CertManagerInjectCAFromAnnotation]; ok {
+			t.Errorf("getValidatingWebhookObjects() %s has unexpected inject-ca-from annotation", wh.GetName())
+		}
+	}
+}
+
+func TestGetValidatingWebhookObjectsWithCertificateSecretRef(t *testing.T) {
+	r := testReconciler(t)
+	secret := testTLSSecret(t, "webhook-tls", "external-secrets-webhook.external-secrets.svc", time.Now().Add(time.Hour))
+	mock := &fakes.FakeCtrlClient{}
+	mock.ExistsCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) (bool, error) {
+		if ns.Name != secret.GetName() {
+			return false, nil
+		}
+		secret.DeepCopyInto(obj.(*corev1.Secret))
+		return true, nil
+	})
+	r.UncachedClient = mock
+	esc := commontest.TestExternalSecretsConfig()
+	esc.Spec.ApplicationConfig.WebhookConfig = &v1alpha1.WebhookConfig{
+		CertificateSecretRef: &v1alpha1.SecretReference{Name: secret.GetName()},
+	}
+
+	webhooks, err := r.getValidatingWebhookObjects(esc, controllerDefaultResourceLabels)
+	if err != nil {
+		t.Fatalf("getValidatingWebhookObjects() err: %v", err)
+	}
+	for _, wh := range webhooks {
+		for _, w := range wh.Webhooks {
+			if !bytes.Equal(w.ClientConfig.CABundle, secret.Data[caCertificateKey]) {
+				t.Errorf("getValidatingWebhookObjects() %s/%s caBundle not set from %s secret", wh.GetName(), w.Name, secret.GetName())
+			}
+		}
+	}
+}
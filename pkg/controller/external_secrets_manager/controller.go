@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -161,12 +162,8 @@ func (r *Reconciler) processReconcileRequest(esm *operatorv1alpha1.ExternalSecre
 	if esm.Status.ControllerStatuses == nil {
 		esm.Status.ControllerStatuses = make([]operatorv1alpha1.ControllerStatus, 0)
 	}
-	if r.esc != nil && len(r.esc.Status.Conditions) > 0 {
-		for _, esCond := range r.esc.Status.Conditions {
-			if r.updateStatusCondition(esm, externalSecretsControllerId, esCond) {
-				statusUpdated = true
-			}
-		}
+	if r.esc != nil && r.updateControllerStatus(esm, externalSecretsControllerId, r.esc.Status.Conditions) {
+		statusUpdated = true
 	}
 
 	if statusUpdated {
@@ -185,43 +182,39 @@ func getControllerCondition(controllerName string, esm *operatorv1alpha1.Externa
 			return &esm.Status.ControllerStatuses[i]
 		}
 	}
-	status := &operatorv1alpha1.ControllerStatus{
+	esm.Status.ControllerStatuses = append(esm.Status.ControllerStatuses, operatorv1alpha1.ControllerStatus{
 		Name:       controllerName,
 		Conditions: make([]operatorv1alpha1.Condition, 0),
-	}
-	esm.Status.ControllerStatuses = append(esm.Status.ControllerStatuses, *status)
-	return status
+	})
+	return &esm.Status.ControllerStatuses[len(esm.Status.ControllerStatuses)-1]
 }
 
-// updateStatusCondition updates the status condition of a specific controller.
-func (r *Reconciler) updateStatusCondition(esm *operatorv1alpha1.ExternalSecretsManager, controllerName string, updCondition metav1.Condition) bool {
+// updateControllerStatus replaces the status conditions of a specific controller with the current conditions
+// of the controller, for the conditions removed from the controller resource to be removed here as well.
+func (r *Reconciler) updateControllerStatus(esm *operatorv1alpha1.ExternalSecretsManager, controllerName string, conditions []metav1.Condition) bool {
 	status := getControllerCondition(controllerName, esm)
 
-	found, condUpdated := false, false
-	for i, c := range status.Conditions {
-		if c.Type == updCondition.Type {
-			found = true
-			if c.Status != updCondition.Status || c.Message != updCondition.Message {
-				status.Conditions[i].Status = updCondition.Status
-				status.Conditions[i].Message = updCondition.Message
-				condUpdated = true
-			}
-		}
+	desired := make([]operatorv1alpha1.Condition, 0, len(conditions))
+	observedGeneration := status.ObservedGeneration
+	for _, c := range conditions {
+		desired = append(desired, operatorv1alpha1.Condition{
+			Type:    c.Type,
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		})
+		observedGeneration = c.ObservedGeneration
 	}
 
-	if !found {
-		status.Conditions = append(status.Conditions, operatorv1alpha1.Condition{
-			Type:    updCondition.Type,
-			Status:  updCondition.Status,
-			Message: updCondition.Message,
-		})
-		condUpdated = true
+	if slices.Equal(status.Conditions, desired) && status.ObservedGeneration == observedGeneration {
+		return false
 	}
 
-	status.ObservedGeneration = updCondition.ObservedGeneration
+	status.Conditions = desired
+	status.ObservedGeneration = observedGeneration
 	esm.Status.LastTransitionTime = metav1.Now()
 
-	return condUpdated
+	return true
 }
 
 // updateStatus is for updating the status subresource of externalsecretsmanagers.operator.openshift.io.
@@ -66,6 +66,13 @@ func TestReconcile(t *testing.T) {
 					}
 					return nil
 				})
+				m.StatusUpdateCalls(func(ctx context.Context, obj client.Object, option ...client.SubResourceUpdateOption) error {
+					switch o := obj.(type) {
+					case *operatorv1alpha1.ExternalSecretsManager:
+						o.DeepCopyInto(esm)
+					}
+					return nil
+				})
 				m.GetCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) error {
 					switch o := obj.(type) {
 					case *operatorv1alpha1.ExternalSecretsConfig:
@@ -80,6 +87,7 @@ func TestReconcile(t *testing.T) {
 										{
 											Type:    operatorv1alpha1.Ready,
 											Status:  metav1.ConditionTrue,
+											Reason:  operatorv1alpha1.ReasonReady,
 											Message: "test ready",
 										},
 										{
@@ -111,6 +119,7 @@ func TestReconcile(t *testing.T) {
 						{
 							Type:    operatorv1alpha1.Ready,
 							Status:  metav1.ConditionTrue,
+							Reason:  operatorv1alpha1.ReasonReady,
 							Message: "test ready",
 						},
 						{
@@ -271,6 +280,148 @@ func TestReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "esm reconciliation removes conditions removed from externalsecretsconfig",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, option ...client.UpdateOption) error {
+					switch o := obj.(type) {
+					case *operatorv1alpha1.ExternalSecretsManager:
+						o.DeepCopyInto(esm)
+					}
+					return nil
+				})
+				m.StatusUpdateCalls(func(ctx context.Context, obj client.Object, option ...client.SubResourceUpdateOption) error {
+					switch o := obj.(type) {
+					case *operatorv1alpha1.ExternalSecretsManager:
+						o.DeepCopyInto(esm)
+					}
+					return nil
+				})
+				m.GetCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) error {
+					switch o := obj.(type) {
+					case *operatorv1alpha1.ExternalSecretsConfig:
+						esc := &operatorv1alpha1.ExternalSecretsConfig{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: common.ExternalSecretsConfigObjectName,
+							},
+							Status: operatorv1alpha1.ExternalSecretsConfigStatus{
+								ConditionalStatus: operatorv1alpha1.ConditionalStatus{
+									Conditions: []metav1.Condition{
+										{
+											Type:    operatorv1alpha1.Ready,
+											Status:  metav1.ConditionTrue,
+											Reason:  operatorv1alpha1.ReasonReady,
+											Message: "test ready",
+										},
+									},
+								},
+							},
+						}
+						esc.DeepCopyInto(o)
+					case *operatorv1alpha1.ExternalSecretsManager:
+						esmObj := &operatorv1alpha1.ExternalSecretsManager{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: common.ExternalSecretsManagerObjectName,
+							},
+							Status: operatorv1alpha1.ExternalSecretsManagerStatus{
+								ControllerStatuses: []operatorv1alpha1.ControllerStatus{
+									{
+										Name: externalSecretsControllerId,
+										Conditions: []operatorv1alpha1.Condition{
+											{
+												Type:    operatorv1alpha1.Ready,
+												Status:  metav1.ConditionTrue,
+												Reason:  operatorv1alpha1.ReasonReady,
+												Message: "test ready",
+											},
+											{
+												Type:    "ReconciliationPaused",
+												Status:  metav1.ConditionTrue,
+												Message: "test paused",
+											},
+										},
+									},
+								},
+							},
+						}
+						esmObj.DeepCopyInto(o)
+					}
+					return nil
+				})
+			},
+			expectedStatusCondition: []operatorv1alpha1.ControllerStatus{
+				{
+					Name: externalSecretsControllerId,
+					Conditions: []operatorv1alpha1.Condition{
+						{
+							Type:    operatorv1alpha1.Ready,
+							Status:  metav1.ConditionTrue,
+							Reason:  operatorv1alpha1.ReasonReady,
+							Message: "test ready",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "esm reconciliation removes all conditions when externalsecretsconfig has none",
+			preReq: func(r *Reconciler, m *fakes.FakeCtrlClient) {
+				m.UpdateWithRetryCalls(func(ctx context.Context, obj client.Object, option ...client.UpdateOption) error {
+					switch o := obj.(type) {
+					case *operatorv1alpha1.ExternalSecretsManager:
+						o.DeepCopyInto(esm)
+					}
+					return nil
+				})
+				m.StatusUpdateCalls(func(ctx context.Context, obj client.Object, option ...client.SubResourceUpdateOption) error {
+					switch o := obj.(type) {
+					case *operatorv1alpha1.ExternalSecretsManager:
+						o.DeepCopyInto(esm)
+					}
+					return nil
+				})
+				m.GetCalls(func(ctx context.Context, ns types.NamespacedName, obj client.Object) error {
+					switch o := obj.(type) {
+					case *operatorv1alpha1.ExternalSecretsConfig:
+						esc := &operatorv1alpha1.ExternalSecretsConfig{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: common.ExternalSecretsConfigObjectName,
+							},
+						}
+						esc.DeepCopyInto(o)
+					case *operatorv1alpha1.ExternalSecretsManager:
+						esmObj := &operatorv1alpha1.ExternalSecretsManager{
+							ObjectMeta: metav1.ObjectMeta{
+								Name: common.ExternalSecretsManagerObjectName,
+							},
+							Status: operatorv1alpha1.ExternalSecretsManagerStatus{
+								ControllerStatuses: []operatorv1alpha1.ControllerStatus{
+									{
+										Name: externalSecretsControllerId,
+										Conditions: []operatorv1alpha1.Condition{
+											{
+												Type:    operatorv1alpha1.Ready,
+												Status:  metav1.ConditionTrue,
+												Reason:  operatorv1alpha1.ReasonReady,
+												Message: "test ready",
+											},
+										},
+									},
+								},
+							},
+						}
+						esmObj.DeepCopyInto(o)
+					}
+					return nil
+				})
+			},
+			expectedStatusCondition: []operatorv1alpha1.ControllerStatus{
+				{
+					Name:       externalSecretsControllerId,
+					Conditions: []operatorv1alpha1.Condition{},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -291,11 +442,13 @@ func TestReconcile(t *testing.T) {
 			if (tt.wantErr != "" || err != nil) && (err == nil || err.Error() != tt.wantErr) {
 				t.Errorf("Reconcile() err: %v, wantErr: %v", err, tt.wantErr)
 			}
-			for _, c1 := range esm.Status.ControllerStatuses {
-				for _, c2 := range tt.expectedStatusCondition {
+			for _, c2 := range tt.expectedStatusCondition {
+				found := false
+				for _, c1 := range esm.Status.ControllerStatuses {
 					if c1.Name != c2.Name {
 						continue
 					}
+					found = true
 
 					// assuming you'll already know the order of the expected conditions from before
 					// given this is only a test.
@@ -303,6 +456,9 @@ func TestReconcile(t *testing.T) {
 						t.Errorf("Reconcile() condition: %+v, expectedStatusCondition: %+v", c1, c2)
 					}
 				}
+				if !found {
+					t.Errorf("Reconcile() status of %s controller not updated", c2.Name)
+				}
 			}
 		})
 	}
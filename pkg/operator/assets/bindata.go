@@ -1,13 +1,19 @@
 // Code generated for package assets by go-bindata DO NOT EDIT. (@generated)
 // sources:
 // bindata/external-secrets/certificate_bitwarden-tls-certs.yml
+// bindata/external-secrets/configmap_service-ca-bundle.yaml
+// bindata/external-secrets/configmap_trusted-ca-bundle.yaml
 // bindata/external-secrets/external-secrets-namespace.yaml
 // bindata/external-secrets/networkpolicy_allow-api-server-and-webhook-traffic.yaml
 // bindata/external-secrets/networkpolicy_allow-api-server-egress-for-bitwarden-sever.yaml
 // bindata/external-secrets/networkpolicy_allow-api-server-egress-for-cert-controller-traffic.yaml
 // bindata/external-secrets/networkpolicy_allow-api-server-egress-for-main-controller-traffic.yaml
 // bindata/external-secrets/networkpolicy_allow-dns.yaml
+// bindata/external-secrets/networkpolicy_allow-metrics-ingress-from-cluster-monitoring.yaml
 // bindata/external-secrets/networkpolicy_deny-all.yaml
+// bindata/external-secrets/poddisruptionbudget_external-secrets-webhook.yaml
+// bindata/external-secrets/poddisruptionbudget_external-secrets.yaml
+// bindata/external-secrets/prometheusrule_external-secrets.yaml
 // bindata/external-secrets/resources/certificate_external-secrets-webhook.yml
 // bindata/external-secrets/resources/clusterrole_external-secrets-cert-controller.yml
 // bindata/external-secrets/resources/clusterrole_external-secrets-controller.yml
@@ -33,6 +39,10 @@
 // bindata/external-secrets/resources/serviceaccount_external-secrets.yml
 // bindata/external-secrets/resources/validatingwebhookconfiguration_externalsecret-validate.yml
 // bindata/external-secrets/resources/validatingwebhookconfiguration_secretstore-validate.yml
+// bindata/external-secrets/role_prometheus-k8s.yaml
+// bindata/external-secrets/rolebinding_prometheus-k8s.yaml
+// bindata/external-secrets/servicemonitor_external-secrets-cert-controller-metrics.yaml
+// bindata/external-secrets/servicemonitor_external-secrets-metrics.yaml
 package assets
 
 import (
@@ -130,6 +140,57 @@ func externalSecretsCertificate_bitwardenTlsCertsYml() (*asset, error) {
 	return a, nil
 }
 
+var _externalSecretsConfigmap_serviceCaBundleYaml = []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: external-secrets-service-ca-bundle
+  namespace: external-secrets
+  labels:
+    app.kubernetes.io/name: external-secrets-service-ca-bundle
+  annotations:
+    service.beta.openshift.io/inject-cabundle: "true"
+`)
+
+func externalSecretsConfigmap_serviceCaBundleYamlBytes() ([]byte, error) {
+	return _externalSecretsConfigmap_serviceCaBundleYaml, nil
+}
+
+func externalSecretsConfigmap_serviceCaBundleYaml() (*asset, error) {
+	bytes, err := externalSecretsConfigmap_serviceCaBundleYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "external-secrets/configmap_service-ca-bundle.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _externalSecretsConfigmap_trustedCaBundleYaml = []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: external-secrets-trusted-ca-bundle
+  namespace: external-secrets
+  labels:
+    app.kubernetes.io/name: external-secrets-trusted-ca-bundle
+    config.openshift.io/inject-trusted-cabundle: "true"
+`)
+
+func externalSecretsConfigmap_trustedCaBundleYamlBytes() ([]byte, error) {
+	return _externalSecretsConfigmap_trustedCaBundleYaml, nil
+}
+
+func externalSecretsConfigmap_trustedCaBundleYaml() (*asset, error) {
+	bytes, err := externalSecretsConfigmap_trustedCaBundleYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "external-secrets/configmap_trusted-ca-bundle.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _externalSecretsExternalSecretsNamespaceYaml = []byte(`apiVersion: v1
 kind: Namespace
 metadata:
@@ -391,6 +452,52 @@ func externalSecretsNetworkpolicy_allowDnsYaml() (*asset, error) {
 	return a, nil
 }
 
+var _externalSecretsNetworkpolicy_allowMetricsIngressFromClusterMonitoringYaml = []byte(`apiVersion: networking.k8s.io/v1
+kind: NetworkPolicy
+metadata:
+  name: allow-metrics-ingress-from-cluster-monitoring
+  namespace: external-secrets
+  labels:
+    app.kubernetes.io/name: external-secrets
+    app.kubernetes.io/instance: external-secrets
+    app.kubernetes.io/version: "v0.19.0"
+    app.kubernetes.io/managed-by: external-secrets-operator
+spec:
+  podSelector:
+    matchExpressions:
+      - key: app.kubernetes.io/name
+        operator: In
+        values:
+          - external-secrets
+          - external-secrets-cert-controller
+  policyTypes:
+    - Ingress
+  ingress:
+    # Allow the cluster monitoring Prometheus to scrape metrics
+    - from:
+      - namespaceSelector:
+          matchLabels:
+            kubernetes.io/metadata.name: openshift-monitoring
+      ports:
+        - protocol: TCP
+          port: 8080
+`)
+
+func externalSecretsNetworkpolicy_allowMetricsIngressFromClusterMonitoringYamlBytes() ([]byte, error) {
+	return _externalSecretsNetworkpolicy_allowMetricsIngressFromClusterMonitoringYaml, nil
+}
+
+func externalSecretsNetworkpolicy_allowMetricsIngressFromClusterMonitoringYaml() (*asset, error) {
+	bytes, err := externalSecretsNetworkpolicy_allowMetricsIngressFromClusterMonitoringYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "external-secrets/networkpolicy_allow-metrics-ingress-from-cluster-monitoring.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _externalSecretsNetworkpolicy_denyAllYaml = []byte(`apiVersion: networking.k8s.io/v1
 kind: NetworkPolicy
 metadata:
@@ -422,6 +529,137 @@ func externalSecretsNetworkpolicy_denyAllYaml() (*asset, error) {
 	return a, nil
 }
 
+var _externalSecretsPoddisruptionbudget_externalSecretsWebhookYaml = []byte(`apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: external-secrets-webhook-pdb
+  namespace: external-secrets
+  labels:
+    app.kubernetes.io/name: external-secrets-webhook
+    app.kubernetes.io/instance: external-secrets
+    app.kubernetes.io/version: "v0.19.0"
+    app.kubernetes.io/managed-by: external-secrets-operator
+spec:
+  minAvailable: 1
+  selector:
+    matchLabels:
+      app.kubernetes.io/name: external-secrets-webhook
+      app.kubernetes.io/instance: external-secrets
+`)
+
+func externalSecretsPoddisruptionbudget_externalSecretsWebhookYamlBytes() ([]byte, error) {
+	return _externalSecretsPoddisruptionbudget_externalSecretsWebhookYaml, nil
+}
+
+func externalSecretsPoddisruptionbudget_externalSecretsWebhookYaml() (*asset, error) {
+	bytes, err := externalSecretsPoddisruptionbudget_externalSecretsWebhookYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "external-secrets/poddisruptionbudget_external-secrets-webhook.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _externalSecretsPoddisruptionbudget_externalSecretsYaml = []byte(`apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: external-secrets-pdb
+  namespace: external-secrets
+  labels:
+    app.kubernetes.io/name: external-secrets
+    app.kubernetes.io/instance: external-secrets
+    app.kubernetes.io/version: "v0.19.0"
+    app.kubernetes.io/managed-by: external-secrets-operator
+spec:
+  minAvailable: 1
+  selector:
+    matchLabels:
+      app.kubernetes.io/name: external-secrets
+      app.kubernetes.io/instance: external-secrets
+`)
+
+func externalSecretsPoddisruptionbudget_externalSecretsYamlBytes() ([]byte, error) {
+	return _externalSecretsPoddisruptionbudget_externalSecretsYaml, nil
+}
+
+func externalSecretsPoddisruptionbudget_externalSecretsYaml() (*asset, error) {
+	bytes, err := externalSecretsPoddisruptionbudget_externalSecretsYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "external-secrets/poddisruptionbudget_external-secrets.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _externalSecretsPrometheusrule_externalSecretsYaml = []byte(`apiVersion: monitoring.coreos.com/v1
+kind: PrometheusRule
+metadata:
+  name: external-secrets-rules
+  namespace: external-secrets
+  labels:
+    app.kubernetes.io/name: external-secrets
+    app.kubernetes.io/instance: external-secrets
+    app.kubernetes.io/version: "v0.19.0"
+    app.kubernetes.io/managed-by: external-secrets-operator
+spec:
+  # the namespace label of the operand metrics is of the scraped target, and the namespace of the
+  # external-secrets.io resources is carried in the exported_namespace label.
+  groups:
+    - name: external-secrets
+      rules:
+        - alert: ExternalSecretSyncErrors
+          expr: sum by (exported_namespace, name) (increase(externalsecret_sync_calls_error{job="external-secrets-metrics",namespace="external-secrets"}[5m])) > 0
+          for: 15m
+          labels:
+            severity: warning
+          annotations:
+            summary: ExternalSecret is failing to sync.
+            description: ExternalSecret {{ $labels.exported_namespace }}/{{ $labels.name }} has been failing to sync the secret from the provider for the last 15 minutes.
+        - alert: ExternalSecretsWebhookUnavailable
+          expr: kube_deployment_status_replicas_available{deployment="external-secrets-webhook",namespace="external-secrets"} == 0
+          for: 5m
+          labels:
+            severity: critical
+          annotations:
+            summary: external-secrets webhook is unavailable.
+            description: No replicas of the external-secrets webhook have been available for the last 5 minutes, the creation and update of the external-secrets.io resources will be rejected.
+        - alert: SecretStoreNotReady
+          expr: max by (exported_namespace, name) (secretstore_status_condition{condition="Ready",status="False",job="external-secrets-metrics",namespace="external-secrets"}) == 1
+          for: 10m
+          labels:
+            severity: warning
+          annotations:
+            summary: SecretStore is not ready.
+            description: SecretStore {{ $labels.exported_namespace }}/{{ $labels.name }} has not been ready for the last 10 minutes.
+        - alert: ClusterSecretStoreNotReady
+          expr: max by (name) (clustersecretstore_status_condition{condition="Ready",status="False",job="external-secrets-metrics",namespace="external-secrets"}) == 1
+          for: 10m
+          labels:
+            severity: warning
+          annotations:
+            summary: ClusterSecretStore is not ready.
+            description: ClusterSecretStore {{ $labels.name }} has not been ready for the last 10 minutes.
+`)
+
+func externalSecretsPrometheusrule_externalSecretsYamlBytes() ([]byte, error) {
+	return _externalSecretsPrometheusrule_externalSecretsYaml, nil
+}
+
+func externalSecretsPrometheusrule_externalSecretsYaml() (*asset, error) {
+	bytes, err := externalSecretsPrometheusrule_externalSecretsYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "external-secrets/prometheusrule_external-secrets.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _externalSecretsResourcesCertificate_externalSecretsWebhookYml = []byte(`---
 apiVersion: cert-manager.io/v1
 kind: Certificate
@@ -1767,6 +2005,163 @@ func externalSecretsResourcesValidatingwebhookconfiguration_secretstoreValidateY
 	return a, nil
 }
 
+var _externalSecretsRole_prometheusK8sYaml = []byte(`apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: external-secrets-prometheus-k8s
+  namespace: external-secrets
+  labels:
+    app.kubernetes.io/name: external-secrets
+    app.kubernetes.io/instance: external-secrets
+    app.kubernetes.io/version: "v0.19.0"
+    app.kubernetes.io/managed-by: external-secrets-operator
+rules:
+  - apiGroups:
+      - ""
+    resources:
+      - "services"
+      - "endpoints"
+      - "pods"
+    verbs:
+      - "get"
+      - "list"
+      - "watch"
+  - apiGroups:
+      - "discovery.k8s.io"
+    resources:
+      - "endpointslices"
+    verbs:
+      - "get"
+      - "list"
+      - "watch"
+`)
+
+func externalSecretsRole_prometheusK8sYamlBytes() ([]byte, error) {
+	return _externalSecretsRole_prometheusK8sYaml, nil
+}
+
+func externalSecretsRole_prometheusK8sYaml() (*asset, error) {
+	bytes, err := externalSecretsRole_prometheusK8sYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "external-secrets/role_prometheus-k8s.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _externalSecretsRolebinding_prometheusK8sYaml = []byte(`apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: external-secrets-prometheus-k8s
+  namespace: external-secrets
+  labels:
+    app.kubernetes.io/name: external-secrets
+    app.kubernetes.io/instance: external-secrets
+    app.kubernetes.io/version: "v0.19.0"
+    app.kubernetes.io/managed-by: external-secrets-operator
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: external-secrets-prometheus-k8s
+subjects:
+  - kind: ServiceAccount
+    name: prometheus-k8s
+    namespace: openshift-monitoring
+`)
+
+func externalSecretsRolebinding_prometheusK8sYamlBytes() ([]byte, error) {
+	return _externalSecretsRolebinding_prometheusK8sYaml, nil
+}
+
+func externalSecretsRolebinding_prometheusK8sYaml() (*asset, error) {
+	bytes, err := externalSecretsRolebinding_prometheusK8sYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "external-secrets/rolebinding_prometheus-k8s.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _externalSecretsServicemonitor_externalSecretsCertControllerMetricsYaml = []byte(`apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: external-secrets-cert-controller-metrics
+  namespace: external-secrets
+  labels:
+    app.kubernetes.io/name: external-secrets-cert-controller
+    app.kubernetes.io/instance: external-secrets
+    app.kubernetes.io/version: "v0.19.0"
+    app.kubernetes.io/managed-by: external-secrets-operator
+spec:
+  endpoints:
+    - port: metrics
+      path: /metrics
+      scheme: http
+      interval: 30s
+      scrapeTimeout: 10s
+  selector:
+    matchLabels:
+      app.kubernetes.io/name: external-secrets-cert-controller
+      app.kubernetes.io/instance: external-secrets
+`)
+
+func externalSecretsServicemonitor_externalSecretsCertControllerMetricsYamlBytes() ([]byte, error) {
+	return _externalSecretsServicemonitor_externalSecretsCertControllerMetricsYaml, nil
+}
+
+func externalSecretsServicemonitor_externalSecretsCertControllerMetricsYaml() (*asset, error) {
+	bytes, err := externalSecretsServicemonitor_externalSecretsCertControllerMetricsYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "external-secrets/servicemonitor_external-secrets-cert-controller-metrics.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _externalSecretsServicemonitor_externalSecretsMetricsYaml = []byte(`apiVersion: monitoring.coreos.com/v1
+kind: ServiceMonitor
+metadata:
+  name: external-secrets-metrics
+  namespace: external-secrets
+  labels:
+    app.kubernetes.io/name: external-secrets
+    app.kubernetes.io/instance: external-secrets
+    app.kubernetes.io/version: "v0.19.0"
+    app.kubernetes.io/managed-by: external-secrets-operator
+spec:
+  endpoints:
+    - port: metrics
+      path: /metrics
+      scheme: http
+      interval: 30s
+      scrapeTimeout: 10s
+  selector:
+    matchLabels:
+      app.kubernetes.io/name: external-secrets
+      app.kubernetes.io/instance: external-secrets
+`)
+
+func externalSecretsServicemonitor_externalSecretsMetricsYamlBytes() ([]byte, error) {
+	return _externalSecretsServicemonitor_externalSecretsMetricsYaml, nil
+}
+
+func externalSecretsServicemonitor_externalSecretsMetricsYaml() (*asset, error) {
+	bytes, err := externalSecretsServicemonitor_externalSecretsMetricsYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "external-secrets/servicemonitor_external-secrets-metrics.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -1820,13 +2215,19 @@ func AssetNames() []string {
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
 	"external-secrets/certificate_bitwarden-tls-certs.yml":                                    externalSecretsCertificate_bitwardenTlsCertsYml,
+	"external-secrets/configmap_service-ca-bundle.yaml":                                       externalSecretsConfigmap_serviceCaBundleYaml,
+	"external-secrets/configmap_trusted-ca-bundle.yaml":                                       externalSecretsConfigmap_trustedCaBundleYaml,
 	"external-secrets/external-secrets-namespace.yaml":                                        externalSecretsExternalSecretsNamespaceYaml,
 	"external-secrets/networkpolicy_allow-api-server-and-webhook-traffic.yaml":                externalSecretsNetworkpolicy_allowApiServerAndWebhookTrafficYaml,
 	"external-secrets/networkpolicy_allow-api-server-egress-for-bitwarden-sever.yaml":         externalSecretsNetworkpolicy_allowApiServerEgressForBitwardenSeverYaml,
 	"external-secrets/networkpolicy_allow-api-server-egress-for-cert-controller-traffic.yaml": externalSecretsNetworkpolicy_allowApiServerEgressForCertControllerTrafficYaml,
 	"external-secrets/networkpolicy_allow-api-server-egress-for-main-controller-traffic.yaml": externalSecretsNetworkpolicy_allowApiServerEgressForMainControllerTrafficYaml,
 	"external-secrets/networkpolicy_allow-dns.yaml":                                           externalSecretsNetworkpolicy_allowDnsYaml,
+	"external-secrets/networkpolicy_allow-metrics-ingress-from-cluster-monitoring.yaml":       externalSecretsNetworkpolicy_allowMetricsIngressFromClusterMonitoringYaml,
 	"external-secrets/networkpolicy_deny-all.yaml":                                            externalSecretsNetworkpolicy_denyAllYaml,
+	"external-secrets/poddisruptionbudget_external-secrets-webhook.yaml":                      externalSecretsPoddisruptionbudget_externalSecretsWebhookYaml,
+	"external-secrets/poddisruptionbudget_external-secrets.yaml":                              externalSecretsPoddisruptionbudget_externalSecretsYaml,
+	"external-secrets/prometheusrule_external-secrets.yaml":                                   externalSecretsPrometheusrule_externalSecretsYaml,
 	"external-secrets/resources/certificate_external-secrets-webhook.yml":                     externalSecretsResourcesCertificate_externalSecretsWebhookYml,
 	"external-secrets/resources/clusterrole_external-secrets-cert-controller.yml":             externalSecretsResourcesClusterrole_externalSecretsCertControllerYml,
 	"external-secrets/resources/clusterrole_external-secrets-controller.yml":                  externalSecretsResourcesClusterrole_externalSecretsControllerYml,
@@ -1852,6 +2253,10 @@ var _bindata = map[string]func() (*asset, error){
 	"external-secrets/resources/serviceaccount_external-secrets.yml":                          externalSecretsResourcesServiceaccount_externalSecretsYml,
 	"external-secrets/resources/validatingwebhookconfiguration_externalsecret-validate.yml":   externalSecretsResourcesValidatingwebhookconfiguration_externalsecretValidateYml,
 	"external-secrets/resources/validatingwebhookconfiguration_secretstore-validate.yml":      externalSecretsResourcesValidatingwebhookconfiguration_secretstoreValidateYml,
+	"external-secrets/role_prometheus-k8s.yaml":                                               externalSecretsRole_prometheusK8sYaml,
+	"external-secrets/rolebinding_prometheus-k8s.yaml":                                        externalSecretsRolebinding_prometheusK8sYaml,
+	"external-secrets/servicemonitor_external-secrets-cert-controller-metrics.yaml":           externalSecretsServicemonitor_externalSecretsCertControllerMetricsYaml,
+	"external-secrets/servicemonitor_external-secrets-metrics.yaml":                           externalSecretsServicemonitor_externalSecretsMetricsYaml,
 }
 
 // AssetDir returns the file names below a certain
@@ -1899,13 +2304,19 @@ type bintree struct {
 var _bintree = &bintree{nil, map[string]*bintree{
 	"external-secrets": {nil, map[string]*bintree{
 		"certificate_bitwarden-tls-certs.yml":                                    {externalSecretsCertificate_bitwardenTlsCertsYml, map[string]*bintree{}},
+		"configmap_service-ca-bundle.yaml":                                       {externalSecretsConfigmap_serviceCaBundleYaml, map[string]*bintree{}},
+		"configmap_trusted-ca-bundle.yaml":                                       {externalSecretsConfigmap_trustedCaBundleYaml, map[string]*bintree{}},
 		"external-secrets-namespace.yaml":                                        {externalSecretsExternalSecretsNamespaceYaml, map[string]*bintree{}},
 		"networkpolicy_allow-api-server-and-webhook-traffic.yaml":                {externalSecretsNetworkpolicy_allowApiServerAndWebhookTrafficYaml, map[string]*bintree{}},
 		"networkpolicy_allow-api-server-egress-for-bitwarden-sever.yaml":         {externalSecretsNetworkpolicy_allowApiServerEgressForBitwardenSeverYaml, map[string]*bintree{}},
 		"networkpolicy_allow-api-server-egress-for-cert-controller-traffic.yaml": {externalSecretsNetworkpolicy_allowApiServerEgressForCertControllerTrafficYaml, map[string]*bintree{}},
 		"networkpolicy_allow-api-server-egress-for-main-controller-traffic.yaml": {externalSecretsNetworkpolicy_allowApiServerEgressForMainControllerTrafficYaml, map[string]*bintree{}},
 		"networkpolicy_allow-dns.yaml":                                           {externalSecretsNetworkpolicy_allowDnsYaml, map[string]*bintree{}},
+		"networkpolicy_allow-metrics-ingress-from-cluster-monitoring.yaml":       {externalSecretsNetworkpolicy_allowMetricsIngressFromClusterMonitoringYaml, map[string]*bintree{}},
 		"networkpolicy_deny-all.yaml":                                            {externalSecretsNetworkpolicy_denyAllYaml, map[string]*bintree{}},
+		"poddisruptionbudget_external-secrets-webhook.yaml":                      {externalSecretsPoddisruptionbudget_externalSecretsWebhookYaml, map[string]*bintree{}},
+		"poddisruptionbudget_external-secrets.yaml":                              {externalSecretsPoddisruptionbudget_externalSecretsYaml, map[string]*bintree{}},
+		"prometheusrule_external-secrets.yaml":                                   {externalSecretsPrometheusrule_externalSecretsYaml, map[string]*bintree{}},
 		"resources": {nil, map[string]*bintree{
 			"certificate_external-secrets-webhook.yml":                   {externalSecretsResourcesCertificate_externalSecretsWebhookYml, map[string]*bintree{}},
 			"clusterrole_external-secrets-cert-controller.yml":           {externalSecretsResourcesClusterrole_externalSecretsCertControllerYml, map[string]*bintree{}},
@@ -1933,6 +2344,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"validatingwebhookconfiguration_externalsecret-validate.yml": {externalSecretsResourcesValidatingwebhookconfiguration_externalsecretValidateYml, map[string]*bintree{}},
 			"validatingwebhookconfiguration_secretstore-validate.yml":    {externalSecretsResourcesValidatingwebhookconfiguration_secretstoreValidateYml, map[string]*bintree{}},
 		}},
+		"role_prometheus-k8s.yaml":                                     {externalSecretsRole_prometheusK8sYaml, map[string]*bintree{}},
+		"rolebinding_prometheus-k8s.yaml":                              {externalSecretsRolebinding_prometheusK8sYaml, map[string]*bintree{}},
+		"servicemonitor_external-secrets-cert-controller-metrics.yaml": {externalSecretsServicemonitor_externalSecretsCertControllerMetricsYaml, map[string]*bintree{}},
+		"servicemonitor_external-secrets-metrics.yaml":                 {externalSecretsServicemonitor_externalSecretsMetricsYaml, map[string]*bintree{}},
 	}},
 }}
 
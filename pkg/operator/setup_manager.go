@@ -2,15 +2,24 @@ package operator
 
 import (
 	"context"
+	"os"
+	"time"
 
+	"k8s.io/apimachinery/pkg/util/wait"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/healthz"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	crdannotator "github.com/openshift/external-secrets-operator/pkg/controller/crd_annotator"
 	escontroller "github.com/openshift/external-secrets-operator/pkg/controller/external_secrets"
 	esmcontroller "github.com/openshift/external-secrets-operator/pkg/controller/external_secrets_manager"
 )
 
+// defaultESMCreateRetryInterval is the interval at which the creation of the default
+// externalsecretsmanagers.operator.openshift.io resource is retried.
+var defaultESMCreateRetryInterval = 5 * time.Second
+
 func StartControllers(ctx context.Context, mgr ctrl.Manager) error {
 	logger := ctrl.Log.WithName("setup")
 
@@ -31,17 +40,28 @@ func StartControllers(ctx context.Context, mgr ctrl.Manager) error {
 		return err
 	}
 
-	if externalSecretsConfig.IsCertManagerInstalled() {
-		crdAnnotator, err := crdannotator.New(mgr)
-		if err != nil {
-			logger.Error(err, "failed to create crd annotator controller", "controller", crdannotator.ControllerName)
-			return err
-		}
-		if err = crdAnnotator.SetupWithManager(mgr); err != nil {
-			logger.Error(err, "failed to set up crd_annotator controller with manager",
-				"controller", crdannotator.ControllerName)
+	// webhooks require the serving certificates, which are provided by OLM, and are
+	// disabled when running the operator locally, or when installed without OLM.
+	var webhookStarted healthz.Checker
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = escontroller.SetupWebhookWithManager(mgr); err != nil {
+			logger.Error(err, "failed to set up validating webhooks with manager")
 			return err
 		}
+		webhookStarted = mgr.GetWebhookServer().StartedChecker()
+	}
+
+	// crd-annotator is required for both cert-manager and service-ca certificate providers, and
+	// for removing the annotations of the provider no longer configured, hence is always started.
+	crdAnnotator, err := crdannotator.New(mgr)
+	if err != nil {
+		logger.Error(err, "failed to create crd annotator controller", "controller", crdannotator.ControllerName)
+		return err
+	}
+	if err = crdAnnotator.SetupWithManager(mgr); err != nil {
+		logger.Error(err, "failed to set up crd_annotator controller with manager",
+			"controller", crdannotator.ControllerName)
+		return err
 	}
 
 	uncachedClient, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
@@ -49,10 +69,39 @@ func StartControllers(ctx context.Context, mgr ctrl.Manager) error {
 		logger.Error(err, "failed to create uncached client")
 		return err
 	}
-	if err = esmcontroller.CreateDefaultESMResource(ctx, uncachedClient); err != nil {
-		logger.Error(err, "failed to create default externalsecretsmanagers.operator.openshift.io resource")
+	// default ExternalSecretsManager is created once the manager is started, as its admission
+	// is validated by the webhook, which must be serving for the creation to not be rejected.
+	if err = mgr.Add(createDefaultESMRunnable(uncachedClient, webhookStarted)); err != nil {
+		logger.Error(err, "failed to add default externalsecretsmanagers.operator.openshift.io resource creation to manager")
 		return err
 	}
 
 	return nil
 }
+
+// createDefaultESMRunnable returns the runnable creating the default externalsecretsmanagers.operator.openshift.io
+// resource. The creation waits for the webhook server to be serving, when the webhooks are enabled, and is retried
+// till it succeeds, as the API server rejects the admission till the webhook service is reachable.
+func createDefaultESMRunnable(c client.Client, webhookStarted healthz.Checker) manager.RunnableFunc {
+	return func(ctx context.Context) error {
+		logger := ctrl.Log.WithName("setup")
+		err := wait.PollUntilContextCancel(ctx, defaultESMCreateRetryInterval, true, func(ctx context.Context) (bool, error) {
+			if webhookStarted != nil {
+				if err := webhookStarted(nil); err != nil {
+					logger.V(1).Info("waiting for webhook server to create default externalsecretsmanagers.operator.openshift.io resource", "reason", err.Error())
+					return false, nil
+				}
+			}
+			if err := esmcontroller.CreateDefaultESMResource(ctx, c); err != nil {
+				logger.Error(err, "failed to create default externalsecretsmanagers.operator.openshift.io resource, retrying")
+				return false, nil
+			}
+			return true, nil
+		})
+		// manager being stopped before the resource could be created is not an error.
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+}
@@ -0,0 +1,120 @@
+package operator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	operatorv1alpha1 "github.com/openshift/external-secrets-operator/api/v1alpha1"
+)
+
+// stubClient records the creation of the ExternalSecretsManager, which fails with
+// createErr for the first createFailures attempts.
+type stubClient struct {
+	client.Client
+	webhookStarted bool
+	createFailures int
+	createCalls    int
+	created        bool
+	// createdBeforeWebhook is set when the creation is attempted before the webhook server is serving.
+	createdBeforeWebhook bool
+}
+
+func (s *stubClient) Create(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+	if _, ok := obj.(*operatorv1alpha1.ExternalSecretsManager); !ok {
+		return fmt.Errorf("unexpected object %T", obj)
+	}
+	s.createCalls++
+	if !s.webhookStarted {
+		s.createdBeforeWebhook = true
+	}
+	if s.createCalls <= s.createFailures {
+		return errors.NewInternalError(fmt.Errorf(`failed calling webhook "vexternalsecretsmanager.operator.openshift.io": connection refused`))
+	}
+	s.created = true
+	return nil
+}
+
+func TestCreateDefaultESMRunnable(t *testing.T) {
+	interval := defaultESMCreateRetryInterval
+	defaultESMCreateRetryInterval = time.Millisecond
+	t.Cleanup(func() { defaultESMCreateRetryInterval = interval })
+
+	tests := []struct {
+		name            string
+		webhooksEnabled bool
+		// webhookStartsAfter is the number of webhook server checks failing before it is serving.
+		webhookStartsAfter int
+		createFailures     int
+		cancelled          bool
+		wantCreated        bool
+	}{
+		{
+			name:        "webhooks disabled",
+			wantCreated: true,
+		},
+		{
+			name:            "webhook server already serving",
+			webhooksEnabled: true,
+			wantCreated:     true,
+		},
+		{
+			name:               "creation waits for webhook server to be serving",
+			webhooksEnabled:    true,
+			webhookStartsAfter: 3,
+			wantCreated:        true,
+		},
+		{
+			name:            "creation rejected while webhook is unreachable is retried",
+			webhooksEnabled: true,
+			// exhausts the retries of a single creation attempt.
+			createFailures: 10,
+			wantCreated:    true,
+		},
+		{
+			name:               "manager stopped before webhook server is serving",
+			webhooksEnabled:    true,
+			webhookStartsAfter: -1,
+			cancelled:          true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &stubClient{webhookStarted: !tt.webhooksEnabled, createFailures: tt.createFailures}
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			var webhookStarted func(*http.Request) error
+			if tt.webhooksEnabled {
+				checks := 0
+				webhookStarted = func(_ *http.Request) error {
+					checks++
+					if tt.webhookStartsAfter < 0 || checks <= tt.webhookStartsAfter {
+						if tt.cancelled {
+							cancel()
+						}
+						return fmt.Errorf("webhook server has not been started yet")
+					}
+					c.webhookStarted = true
+					return nil
+				}
+			}
+
+			if err := createDefaultESMRunnable(c, webhookStarted).Start(ctx); err != nil {
+				t.Fatalf("createDefaultESMRunnable() unexpected error: %v", err)
+			}
+			if c.created != tt.wantCreated {
+				t.Errorf("createDefaultESMRunnable() created: %v, want: %v", c.created, tt.wantCreated)
+			}
+			if c.createdBeforeWebhook {
+				t.Errorf("createDefaultESMRunnable() attempted creation before webhook server is serving")
+			}
+		})
+	}
+}